@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+)
+
+var pairingCodePattern = regexp.MustCompile(`^\d{4}$`)
+
+// runAuth pairs with the Anytype desktop app and stores the API key in the config file.
+func runAuth(ctx context.Context, args []string, stdin io.Reader, stdout io.Writer, getenv func(string) string, defaultPath string) error {
+	if path := getenv("ANYTYPE_MCP_CONFIG"); path != "" {
+		defaultPath = path
+	}
+
+	fs := flag.NewFlagSet("anytype-mcp-lite auth", flag.ContinueOnError)
+	configPath := fs.String("config", defaultPath, "the JSON config file to store the API key (env: ANYTYPE_MCP_CONFIG)")
+	apiServer := fs.String("api-server", "", "the Anytype API server URL, defaults to the configured server")
+	appName := fs.String("app-name", "anytype-mcp-lite", "the app name displayed in Anytype")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, _, err := loadConfig(nil, withoutConfigEnv(getenv), *configPath)
+	if err != nil {
+		return err
+	}
+
+	if *apiServer != "" {
+		cfg.ApiServer = *apiServer
+	}
+
+	client := anytype.New("", anytype.WithApiServer(cfg.ApiServer), anytype.WithApiVersion(cfg.ApiVersion))
+
+	challenge, err := client.CreateChallenge(ctx, anytype.CreateChallengeInput{
+		Body: anytype.CreateChallengeBody{AppName: *appName},
+	})
+	if err != nil {
+		return fmt.Errorf("create challenge: %w", err)
+	}
+
+	fmt.Fprint(stdout, "Enter the 4-digit code shown in Anytype: ")
+	line, err := bufio.NewReader(stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	code := strings.TrimSpace(line)
+	if !pairingCodePattern.MatchString(code) {
+		return fmt.Errorf("invalid code %q, expected 4 digits", code)
+	}
+
+	key, err := client.CreateApiKey(ctx, anytype.CreateApiKeyInput{
+		Body: anytype.CreateApiKeyBody{ChallengeId: challenge.ChallengeId, Code: code},
+	})
+	if err != nil {
+		return fmt.Errorf("create api key: %w", err)
+	}
+
+	if err := saveApiKey(*configPath, key.ApiKey); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "API key saved to %s\n", *configPath)
+	return nil
+}
+
+// saveApiKey writes the API key into the config file and keeps the other settings.
+func saveApiKey(path, apiKey string) error {
+	settings := make(map[string]any)
+
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &settings); err != nil {
+			return fmt.Errorf("config file %s: %w", path, err)
+		}
+	}
+
+	settings["apiKey"] = apiKey
+
+	data, err = json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
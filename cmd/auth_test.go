@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+)
+
+func newAuthServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v1/auth/challenges":
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(anytype.CreateChallengeOutput{ChallengeId: "challenge1"})
+		case "/v1/auth/api_keys":
+			var body anytype.CreateApiKeyBody
+			json.NewDecoder(r.Body).Decode(&body)
+			if body.ChallengeId != "challenge1" || body.Code != "1234" {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(anytype.Error{Code: "bad_request", Message: "Invalid code", Status: 400})
+				return
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(anytype.CreateApiKeyOutput{ApiKey: "paired-key"})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+}
+
+func TestRunAuth_Success(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		expected map[string]any
+	}{
+		{
+			name:     "new config file",
+			expected: map[string]any{"apiKey": "paired-key"},
+		},
+		{
+			name:     "keeps existing settings",
+			existing: `{"apiKey":"old-key","tokenBudget":500}`,
+			expected: map[string]any{"apiKey": "paired-key", "tokenBudget": 500.0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newAuthServer(t)
+			defer server.Close()
+
+			path := filepath.Join(t.TempDir(), "anytype-mcp-lite", "config.json")
+			if tt.existing != "" {
+				os.MkdirAll(filepath.Dir(path), 0o700)
+				if err := os.WriteFile(path, []byte(tt.existing), 0o600); err != nil {
+					t.Fatalf("failed to write config file: %v", err)
+				}
+			}
+
+			var stdout bytes.Buffer
+			getenv := func(string) string { return "" }
+			err := runAuth(context.Background(), []string{"--api-server", server.URL}, strings.NewReader("1234\n"), &stdout, getenv, path)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read config file: %v", err)
+			}
+
+			var saved map[string]any
+			if err := json.Unmarshal(data, &saved); err != nil {
+				t.Fatalf("failed to decode config file: %v", err)
+			}
+
+			if !reflect.DeepEqual(saved, tt.expected) {
+				t.Errorf("expected config %v, got %v", tt.expected, saved)
+			}
+
+			cfg, _, err := loadConfig(nil, getenv, path)
+			if err != nil {
+				t.Fatalf("failed to load saved config: %v", err)
+			}
+
+			if cfg.ApiKey != "paired-key" {
+				t.Errorf("expected server to pick up api key paired-key, got %s", cfg.ApiKey)
+			}
+		})
+	}
+}
+
+func TestRunAuth_InvalidCode(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"not digits", "abcd\n"},
+		{"rejected by anytype", "0000\n"},
+		{"empty input", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newAuthServer(t)
+			defer server.Close()
+
+			path := filepath.Join(t.TempDir(), "config.json")
+			getenv := func(string) string { return "" }
+			err := runAuth(context.Background(), []string{"--api-server", server.URL}, strings.NewReader(tt.input), &bytes.Buffer{}, getenv, path)
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+
+			if _, err := os.Stat(path); !os.IsNotExist(err) {
+				t.Error("expected config file not to be written")
+			}
+		})
+	}
+}
@@ -0,0 +1,392 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/elct9620/anytype-mcp-lite/server"
+)
+
+// redacted replaces secrets when printing the effective config.
+const redacted = "<redacted>"
+
+// Duration decodes a duration from a string like "30s" in the config file.
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(duration)
+	return nil
+}
+
+// Config is the effective configuration, the precedence is flags > environment variables > config file > defaults.
+type Config struct {
+	ApiServer   string   `json:"apiServer"`
+	ApiKey      string   `json:"apiKey,omitempty"`
+	ApiKeyFile  string   `json:"apiKeyFile,omitempty"`
+	ApiVersion  string   `json:"apiVersion"`
+	Timeout     Duration `json:"timeout"`
+	MaxRetries  int      `json:"maxRetries"`
+	RateLimit   float64  `json:"rateLimit"`
+	CacheTTL    Duration `json:"cacheTTL"`
+	CacheSize   int      `json:"cacheSize"`
+	Tools       []string `json:"tools,omitempty"`
+	EnableWrite bool     `json:"enableWrite"`
+	TokenBudget int      `json:"tokenBudget"`
+	Transport   string   `json:"transport"`
+	Listen      string   `json:"listen"`
+	AuthToken   string   `json:"authToken,omitempty"`
+	Mirror      string   `json:"mirror,omitempty"`
+
+	PollInterval Duration `json:"pollInterval"`
+
+	Semantic          bool   `json:"semantic"`
+	Embedder          string `json:"embedder"`
+	EmbeddingEndpoint string `json:"embeddingEndpoint"`
+	EmbeddingModel    string `json:"embeddingModel"`
+	EmbeddingApiKey   string `json:"embeddingApiKey,omitempty"`
+	SemanticIndex     string `json:"semanticIndex,omitempty"`
+}
+
+func defaultConfig() Config {
+	return Config{
+		ApiServer:  "http://127.0.0.1:31009",
+		ApiVersion: anytype.APIVersion,
+		Timeout:    Duration(30 * time.Second),
+		MaxRetries: anytype.DefaultRetryPolicy().MaxRetries,
+		CacheSize:  anytype.DefaultCacheSize,
+		Transport:  "stdio",
+		Listen:     "127.0.0.1:8080",
+		Mirror:     defaultCachePath("mirror.json"),
+
+		PollInterval: Duration(server.DefaultPollInterval),
+
+		Embedder:          "hash",
+		EmbeddingEndpoint: "http://127.0.0.1:11434/v1",
+		EmbeddingModel:    "nomic-embed-text",
+		SemanticIndex:     defaultCachePath("semantic-index.json"),
+	}
+}
+
+// toolNames are the tools registered by the server, the --tools setting is validated against them.
+var toolNames = []string{
+	"search", "semantic-search", "get-object", "get-links", "list-spaces", "describe-space", "list-members",
+	"list-templates", "list-tags", "list-view-objects",
+	"create-object", "update-object", "archive-object", "create-tag", "update-tag", "delete-tag",
+}
+
+// ToolEnabled reports whether the tool is enabled, all tools are enabled when the list is empty.
+func (c *Config) ToolEnabled(name string) bool {
+	return len(c.Tools) == 0 || slices.Contains(c.Tools, name)
+}
+
+// Redacted returns a copy of the config which is safe to print.
+func (c Config) Redacted() Config {
+	if c.ApiKey != "" {
+		c.ApiKey = redacted
+	}
+
+	if c.AuthToken != "" {
+		c.AuthToken = redacted
+	}
+
+	if c.EmbeddingApiKey != "" {
+		c.EmbeddingApiKey = redacted
+	}
+
+	return c
+}
+
+type setting struct {
+	flag   string
+	env    string
+	usage  string
+	isBool bool
+	set    func(*Config, string) error
+}
+
+var settings = []setting{
+	{"api-server", "ANYTYPE_API_SERVER", "the Anytype API server URL", false, func(c *Config, v string) error {
+		c.ApiServer = v
+		return nil
+	}},
+	{"api-key", "ANYTYPE_API_KEY", "the Anytype API key", false, func(c *Config, v string) error {
+		c.ApiKey = v
+		return nil
+	}},
+	{"api-key-file", "ANYTYPE_API_KEY_FILE", "the file to read the Anytype API key from", false, func(c *Config, v string) error {
+		c.ApiKeyFile = v
+		return nil
+	}},
+	{"api-version", "ANYTYPE_API_VERSION", "the Anytype API version", false, func(c *Config, v string) error {
+		c.ApiVersion = v
+		return nil
+	}},
+	{"timeout", "ANYTYPE_TIMEOUT", "the timeout of each Anytype API request, e.g. 30s", false, func(c *Config, v string) error {
+		timeout, err := time.ParseDuration(v)
+		c.Timeout = Duration(timeout)
+		return err
+	}},
+	{"max-retries", "ANYTYPE_MAX_RETRIES", "maximum retries of a failed Anytype API request, 0 disables retry", false, func(c *Config, v string) error {
+		retries, err := strconv.Atoi(v)
+		c.MaxRetries = retries
+		return err
+	}},
+	{"rate-limit", "ANYTYPE_RATE_LIMIT", "maximum Anytype API requests per second, 0 is unlimited", false, func(c *Config, v string) error {
+		rate, err := strconv.ParseFloat(v, 64)
+		c.RateLimit = rate
+		return err
+	}},
+	{"cache-ttl", "ANYTYPE_CACHE_TTL", "cache Anytype API responses for the duration, e.g. 1m, 0 disables cache", false, func(c *Config, v string) error {
+		ttl, err := time.ParseDuration(v)
+		c.CacheTTL = Duration(ttl)
+		return err
+	}},
+	{"cache-size", "ANYTYPE_CACHE_SIZE", "maximum cached Anytype API responses", false, func(c *Config, v string) error {
+		size, err := strconv.Atoi(v)
+		c.CacheSize = size
+		return err
+	}},
+	{"tools", "ANYTYPE_MCP_TOOLS", "comma separated tools to enable, empty enables all tools", false, func(c *Config, v string) error {
+		c.Tools = nil
+		for _, tool := range strings.Split(v, ",") {
+			if tool = strings.TrimSpace(tool); tool != "" {
+				c.Tools = append(c.Tools, tool)
+			}
+		}
+		return nil
+	}},
+	{"enable-write", "ANYTYPE_ENABLE_WRITE", "enable tools to create, update and archive objects", true, func(c *Config, v string) error {
+		enabled, err := strconv.ParseBool(v)
+		c.EnableWrite = enabled
+		return err
+	}},
+	{"token-budget", "ANYTYPE_TOKEN_BUDGET", "maximum estimated tokens of markdown returned per call, 0 is unlimited", false, func(c *Config, v string) error {
+		budget, err := strconv.Atoi(v)
+		c.TokenBudget = budget
+		return err
+	}},
+	{"transport", "ANYTYPE_MCP_TRANSPORT", "the MCP transport, stdio or http", false, func(c *Config, v string) error {
+		c.Transport = v
+		return nil
+	}},
+	{"listen", "ANYTYPE_MCP_LISTEN", "the address to listen on with http transport", false, func(c *Config, v string) error {
+		c.Listen = v
+		return nil
+	}},
+	{"mirror", "ANYTYPE_MCP_MIRROR", "the offline mirror file written by the sync command, empty to disable", false, func(c *Config, v string) error {
+		c.Mirror = v
+		return nil
+	}},
+	{"poll-interval", "ANYTYPE_MCP_POLL_INTERVAL", "the interval to check subscribed resources for changes, e.g. 30s, 0 disables subscriptions", false, func(c *Config, v string) error {
+		interval, err := time.ParseDuration(v)
+		c.PollInterval = Duration(interval)
+		return err
+	}},
+	{"semantic", "ANYTYPE_MCP_SEMANTIC", "enable semantic search with a local index of the offline mirror refreshed in the background and by the sync command", true, func(c *Config, v string) error {
+		enabled, err := strconv.ParseBool(v)
+		c.Semantic = enabled
+		return err
+	}},
+	{"embedder", "ANYTYPE_MCP_EMBEDDER", "the embedder of semantic search, hash or openai", false, func(c *Config, v string) error {
+		c.Embedder = v
+		return nil
+	}},
+	{"embedding-endpoint", "ANYTYPE_MCP_EMBEDDING_ENDPOINT", "the OpenAI compatible API base URL of the openai embedder", false, func(c *Config, v string) error {
+		c.EmbeddingEndpoint = v
+		return nil
+	}},
+	{"embedding-model", "ANYTYPE_MCP_EMBEDDING_MODEL", "the embedding model of the openai embedder", false, func(c *Config, v string) error {
+		c.EmbeddingModel = v
+		return nil
+	}},
+	{"embedding-api-key", "ANYTYPE_MCP_EMBEDDING_API_KEY", "the API key of the openai embedder", false, func(c *Config, v string) error {
+		c.EmbeddingApiKey = v
+		return nil
+	}},
+	{"semantic-index", "ANYTYPE_MCP_SEMANTIC_INDEX", "the file to keep the semantic search index, empty to keep in memory", false, func(c *Config, v string) error {
+		c.SemanticIndex = v
+		return nil
+	}},
+	{"auth-token", "ANYTYPE_MCP_AUTH_TOKEN", "the bearer token required by http transport, empty to disable", false, func(c *Config, v string) error {
+		c.AuthToken = v
+		return nil
+	}},
+}
+
+// flagValue collects the raw flag value to apply it after the config file and environment variables.
+type flagValue struct {
+	value  string
+	isBool bool
+}
+
+func (f *flagValue) String() string     { return f.value }
+func (f *flagValue) Set(v string) error { f.value = v; return nil }
+func (f *flagValue) IsBoolFlag() bool   { return f.isBool }
+
+// loadConfig resolves the config from the command line arguments, environment variables and config file.
+// The defaultPath is loaded when exists and no config file is given.
+func loadConfig(args []string, getenv func(string) string, defaultPath string) (*Config, bool, error) {
+	fs := flag.NewFlagSet("anytype-mcp-lite", flag.ContinueOnError)
+	configPath := fs.String("config", getenv("ANYTYPE_MCP_CONFIG"), "the JSON config file (env: ANYTYPE_MCP_CONFIG)")
+	printConfig := fs.Bool("print-config", false, "print the effective config with secrets redacted and exit")
+
+	values := make(map[string]*flagValue, len(settings))
+	for _, s := range settings {
+		values[s.flag] = &flagValue{isBool: s.isBool}
+		fs.Var(values[s.flag], s.flag, fmt.Sprintf("%s (env: %s)", s.usage, s.env))
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, false, err
+	}
+
+	cfg := defaultConfig()
+
+	path, required := *configPath, *configPath != ""
+	if !required {
+		path = defaultPath
+	}
+
+	if path != "" {
+		if err := readConfigFile(path, &cfg); err != nil && (required || !errors.Is(err, os.ErrNotExist)) {
+			return nil, false, fmt.Errorf("config file %s: %w", path, err)
+		}
+		resolveApiKey(&cfg, cfg.ApiKey != "", cfg.ApiKeyFile != "")
+	}
+
+	for _, s := range settings {
+		if value := getenv(s.env); value != "" {
+			if err := s.set(&cfg, value); err != nil {
+				return nil, false, fmt.Errorf("invalid %s: %w", s.env, err)
+			}
+		}
+	}
+	resolveApiKey(&cfg, getenv("ANYTYPE_API_KEY") != "", getenv("ANYTYPE_API_KEY_FILE") != "")
+
+	var flagErr error
+	visited := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		visited[f.Name] = true
+		for _, s := range settings {
+			if s.flag == f.Name && flagErr == nil {
+				if err := s.set(&cfg, values[s.flag].value); err != nil {
+					flagErr = fmt.Errorf("invalid --%s: %w", s.flag, err)
+				}
+			}
+		}
+	})
+	if flagErr != nil {
+		return nil, false, flagErr
+	}
+	resolveApiKey(&cfg, visited["api-key"], visited["api-key-file"])
+
+	if cfg.ApiKey == "" && cfg.ApiKeyFile != "" {
+		key, err := os.ReadFile(cfg.ApiKeyFile)
+		if err != nil {
+			return nil, false, fmt.Errorf("api key file: %w", err)
+		}
+		cfg.ApiKey = strings.TrimSpace(string(key))
+	}
+
+	if cfg.Transport != "stdio" && cfg.Transport != "http" {
+		return nil, false, fmt.Errorf("unknown transport %q, expected stdio or http", cfg.Transport)
+	}
+
+	if cfg.Embedder != "hash" && cfg.Embedder != "openai" {
+		return nil, false, fmt.Errorf("unknown embedder %q, expected hash or openai", cfg.Embedder)
+	}
+
+	for _, tool := range cfg.Tools {
+		if !slices.Contains(toolNames, tool) {
+			return nil, false, fmt.Errorf("unknown tool %q, expected one of: %s", tool, strings.Join(toolNames, ", "))
+		}
+	}
+
+	return &cfg, *printConfig, nil
+}
+
+// withoutConfigEnv hides ANYTYPE_MCP_CONFIG for the subcommands which already resolved the config path with their --config flag.
+func withoutConfigEnv(getenv func(string) string) func(string) string {
+	return func(key string) string {
+		if key == "ANYTYPE_MCP_CONFIG" {
+			return ""
+		}
+		return getenv(key)
+	}
+}
+
+// resolveApiKey keeps the api key or key file set by the same source, so a key file replaces the key
+// of a lower source and the key wins when a source sets both.
+func resolveApiKey(cfg *Config, key, keyFile bool) {
+	switch {
+	case key:
+		cfg.ApiKeyFile = ""
+	case keyFile:
+		cfg.ApiKey = ""
+	}
+}
+
+// readConfigFile decodes the JSON config file, other formats are rejected to not silently ignore the settings.
+func readConfigFile(path string, cfg *Config) error {
+	if ext := filepath.Ext(path); ext != ".json" && ext != "" {
+		return fmt.Errorf("unsupported config format %q, only JSON is supported", ext)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(file)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// defaultConfigPath returns the config file in the user config directory, e.g. ~/.config/anytype-mcp-lite/config.json
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, "anytype-mcp-lite", "config.json")
+}
+
+// defaultCachePath returns the file in the user cache directory, e.g. ~/.cache/anytype-mcp-lite/mirror.json
+func defaultCachePath(name string) string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, "anytype-mcp-lite", name)
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLoadConfig_Precedence(t *testing.T) {
+	dir := t.TempDir()
+
+	configFile := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(configFile, []byte(`{"apiServer":"http://file:31009","apiKey":"file-key","tokenBudget":500,"tools":["search"],"timeout":"5s"}`), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	keyFile := filepath.Join(dir, "api-key")
+	if err := os.WriteFile(keyFile, []byte("key-from-file\n"), 0o600); err != nil {
+		t.Fatalf("failed to write key file: %v", err)
+	}
+
+	keyFileConfig := filepath.Join(dir, "key-file.json")
+	if err := os.WriteFile(keyFileConfig, []byte(`{"apiKeyFile":"`+keyFile+`"}`), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		args        []string
+		env         map[string]string
+		defaultPath string
+		expected    func(cfg *Config)
+	}{
+		{
+			name:     "defaults without config file",
+			expected: func(cfg *Config) {},
+		},
+		{
+			name:        "missing default config file is ignored",
+			defaultPath: filepath.Join(dir, "missing.json"),
+			expected:    func(cfg *Config) {},
+		},
+		{
+			name:        "default config file",
+			defaultPath: configFile,
+			expected: func(cfg *Config) {
+				cfg.ApiServer = "http://file:31009"
+				cfg.ApiKey = "file-key"
+				cfg.TokenBudget = 500
+				cfg.Tools = []string{"search"}
+				cfg.Timeout = Duration(5 * time.Second)
+			},
+		},
+		{
+			name: "environment overrides config file",
+			env: map[string]string{
+				"ANYTYPE_MCP_CONFIG":   configFile,
+				"ANYTYPE_API_KEY":      "env-key",
+				"ANYTYPE_TOKEN_BUDGET": "200",
+				"ANYTYPE_ENABLE_WRITE": "true",
+				"ANYTYPE_RATE_LIMIT":   "2.5",
+			},
+			expected: func(cfg *Config) {
+				cfg.ApiServer = "http://file:31009"
+				cfg.RateLimit = 2.5
+				cfg.ApiKey = "env-key"
+				cfg.TokenBudget = 200
+				cfg.Tools = []string{"search"}
+				cfg.Timeout = Duration(5 * time.Second)
+				cfg.EnableWrite = true
+			},
+		},
+		{
+			name: "flags override environment",
+			args: []string{"--config", configFile, "--token-budget", "100", "--tools", "search, get-object", "--enable-write=false", "--transport", "http", "--max-retries", "0"},
+			env: map[string]string{
+				"ANYTYPE_TOKEN_BUDGET": "200",
+				"ANYTYPE_ENABLE_WRITE": "true",
+			},
+			expected: func(cfg *Config) {
+				cfg.ApiServer = "http://file:31009"
+				cfg.ApiKey = "file-key"
+				cfg.TokenBudget = 100
+				cfg.Tools = []string{"search", "get-object"}
+				cfg.Timeout = Duration(5 * time.Second)
+				cfg.Transport = "http"
+				cfg.MaxRetries = 0
+			},
+		},
+		{
+			name: "api key file",
+			args: []string{"--api-key-file", keyFile, "--api-version", "2025-11-08", "--cache-ttl", "1m", "--poll-interval", "0"},
+			expected: func(cfg *Config) {
+				cfg.CacheTTL = Duration(time.Minute)
+				cfg.PollInterval = 0
+				cfg.ApiKeyFile = keyFile
+				cfg.ApiKey = "key-from-file"
+				cfg.ApiVersion = "2025-11-08"
+			},
+		},
+		{
+			name: "api key file flag overrides config file key",
+			args: []string{"--config", configFile, "--api-key-file", keyFile},
+			expected: func(cfg *Config) {
+				cfg.ApiServer = "http://file:31009"
+				cfg.ApiKeyFile = keyFile
+				cfg.ApiKey = "key-from-file"
+				cfg.TokenBudget = 500
+				cfg.Tools = []string{"search"}
+				cfg.Timeout = Duration(5 * time.Second)
+			},
+		},
+		{
+			name: "api key environment overrides config file key file",
+			env: map[string]string{
+				"ANYTYPE_MCP_CONFIG": keyFileConfig,
+				"ANYTYPE_API_KEY":    "env-key",
+			},
+			expected: func(cfg *Config) {
+				cfg.ApiKey = "env-key"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			getenv := func(key string) string { return tt.env[key] }
+			cfg, _, err := loadConfig(tt.args, getenv, tt.defaultPath)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			expected := defaultConfig()
+			tt.expected(&expected)
+			if !reflect.DeepEqual(*cfg, expected) {
+				t.Errorf("expected config %+v, got %+v", expected, *cfg)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	invalidFile := filepath.Join(dir, "invalid.json")
+	if err := os.WriteFile(invalidFile, []byte(`{"unknown":true}`), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	yamlFile := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(yamlFile, []byte("apiKey: secret\n"), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		env  map[string]string
+	}{
+		{"missing explicit config file", []string{"--config", filepath.Join(dir, "missing.json")}, nil},
+		{"unknown config field", []string{"--config", invalidFile}, nil},
+		{"invalid environment value", nil, map[string]string{"ANYTYPE_TOKEN_BUDGET": "many"}},
+		{"invalid flag value", []string{"--timeout", "soon"}, nil},
+		{"unknown transport", []string{"--transport", "grpc"}, nil},
+		{"unknown embedder", []string{"--embedder", "bert"}, nil},
+		{"missing api key file", []string{"--api-key-file", filepath.Join(dir, "missing")}, nil},
+		{"unsupported config format", []string{"--config", yamlFile}, nil},
+		{"unknown tool", []string{"--tools", "search,get-objects"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			getenv := func(key string) string { return tt.env[key] }
+			if _, _, err := loadConfig(tt.args, getenv, ""); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestConfig_Redacted(t *testing.T) {
+	cfg := Config{ApiKey: "secret", AuthToken: "token", EmbeddingApiKey: "embedding", ApiServer: "http://127.0.0.1:31009"}
+
+	result := cfg.Redacted()
+	expected := Config{ApiKey: redacted, AuthToken: redacted, EmbeddingApiKey: redacted, ApiServer: "http://127.0.0.1:31009"}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %+v, got %+v", expected, result)
+	}
+
+	if cfg.ApiKey != "secret" {
+		t.Error("expected original config not to be modified")
+	}
+}
+
+func TestConfig_ToolEnabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		tools    []string
+		tool     string
+		expected bool
+	}{
+		{"all tools by default", nil, "search", true},
+		{"listed tool", []string{"search"}, "search", true},
+		{"unlisted tool", []string{"search"}, "get-object", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := Config{Tools: tt.tools}
+			if result := cfg.ToolEnabled(tt.tool); result != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -2,10 +2,21 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"flag"
 	"log"
+	"math"
+	"net"
 	"os"
+	"os/signal"
+	"slices"
+	"syscall"
+	"time"
 
 	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/elct9620/anytype-mcp-lite/pkg/mirror"
+	"github.com/elct9620/anytype-mcp-lite/pkg/semantic"
 	"github.com/elct9620/anytype-mcp-lite/server"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
@@ -15,23 +26,248 @@ const Version = "0.1.3"
 
 // x-release-please-end
 
+// cacheStatsInterval is the interval to log the cache stats when they are changed.
+const cacheStatsInterval = 5 * time.Minute
+
+const (
+	readOnlyInstructions = "Provide read-only access to Anytype workspace. Help user to retrieve information from their Anytype."
+	writeInstructions    = "Provide access to Anytype workspace. Help user to retrieve information from their Anytype and capture or update objects when asked."
+)
+
 func main() {
-	anytype := anytype.New(os.Getenv("ANYTYPE_API_KEY"))
-	anytypeMcp := server.New(anytype)
+	if len(os.Args) > 1 && os.Args[1] == "auth" {
+		if err := runAuth(context.Background(), os.Args[2:], os.Stdin, os.Stdout, os.Getenv, defaultConfigPath()); err != nil && !errors.Is(err, flag.ErrHelp) {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "sync" {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if err := runSync(ctx, os.Args[2:], os.Stdout, os.Getenv, defaultConfigPath()); err != nil && !errors.Is(err, flag.ErrHelp) {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	cfg, printConfig, err := loadConfig(os.Args[1:], os.Getenv, defaultConfigPath())
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if printConfig {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(cfg.Redacted()); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	retry := anytype.DefaultRetryPolicy()
+	retry.MaxRetries = cfg.MaxRetries
 
-	server := mcp.NewServer(&mcp.Implementation{
+	anytype := anytype.New(
+		cfg.ApiKey,
+		anytype.WithApiServer(cfg.ApiServer),
+		anytype.WithApiVersion(cfg.ApiVersion),
+		anytype.WithTimeout(time.Duration(cfg.Timeout)),
+		anytype.WithRetry(retry),
+		anytype.WithRateLimit(cfg.RateLimit, int(math.Ceil(cfg.RateLimit))),
+		anytype.WithCache(time.Duration(cfg.CacheTTL), cfg.CacheSize),
+	)
+	if cfg.CacheTTL > 0 {
+		defer func() { logCacheStats(anytype.CacheStats()) }()
+	}
+
+	appOptions := []server.AppOption{server.WithTokenBudget(cfg.TokenBudget)}
+	var store *mirror.Store
+	if cfg.Mirror != "" {
+		store, err = mirror.Open(cfg.Mirror)
+		if err != nil {
+			log.Fatal(err)
+		}
+		appOptions = append(appOptions, server.WithMirror(store))
+	}
+
+	var index *semantic.Index
+	if cfg.Semantic {
+		if store == nil {
+			log.Fatal("semantic search requires the offline mirror, use --mirror to set the path")
+		}
+
+		index, err = openSemanticIndex(cfg)
+		if err != nil {
+			log.Fatal(err)
+		}
+		appOptions = append(appOptions, server.WithSemanticIndex(index))
+	}
+
+	anytypeMcp := server.New(anytype, appOptions...)
+
+	instructions := readOnlyInstructions
+	if cfg.EnableWrite {
+		instructions = writeInstructions
+	}
+
+	serverOptions := &mcp.ServerOptions{
+		Instructions: instructions,
+	}
+
+	var poller *server.Poller
+	if cfg.PollInterval > 0 {
+		poller = server.NewPoller(anytype, time.Duration(cfg.PollInterval))
+		serverOptions.SubscribeHandler = poller.Subscribe
+		serverOptions.UnsubscribeHandler = poller.Unsubscribe
+	}
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{
 		Name:    "anytype",
 		Title:   "Anytype MCP",
 		Version: "v" + Version,
-	},
-		&mcp.ServerOptions{
-			Instructions: "Provide read-only access to Anytype workspace. Help user to retrieve information from their Anytype.",
+	}, serverOptions)
+	addTool(mcpServer, cfg, &mcp.Tool{Name: "search", Description: "search objects in anytype"}, anytypeMcp.Search)
+	if index != nil {
+		addTool(mcpServer, cfg, &mcp.Tool{Name: "semantic-search", Description: "search objects in anytype by meaning, for paraphrased or vague queries"}, anytypeMcp.SemanticSearch)
+	}
+	addTool(mcpServer, cfg, &mcp.Tool{Name: "get-object", Description: "get an object from anytype"}, anytypeMcp.GetObject)
+	addTool(mcpServer, cfg, &mcp.Tool{Name: "get-links", Description: "get outgoing links and backlinks of an object in anytype"}, anytypeMcp.GetLinks)
+	addTool(mcpServer, cfg, &mcp.Tool{Name: "list-spaces", Description: "list spaces in anytype"}, anytypeMcp.ListSpaces)
+	addTool(mcpServer, cfg, &mcp.Tool{Name: "describe-space", Description: "describe object types and properties of a space in anytype"}, anytypeMcp.DescribeSpace)
+	addTool(mcpServer, cfg, &mcp.Tool{Name: "list-members", Description: "list members of a shared space in anytype"}, anytypeMcp.ListMembers)
+	addTool(mcpServer, cfg, &mcp.Tool{Name: "list-templates", Description: "list templates of an object type in anytype"}, anytypeMcp.ListTemplates)
+	addTool(mcpServer, cfg, &mcp.Tool{Name: "list-tags", Description: "list tags of a select or multi_select property in anytype"}, anytypeMcp.ListTags)
+	addTool(mcpServer, cfg, &mcp.Tool{Name: "list-view-objects", Description: "list objects in a view of a set or collection in anytype"}, anytypeMcp.ListViewObjects)
+
+	if cfg.EnableWrite {
+		addTool(mcpServer, cfg, &mcp.Tool{Name: "create-object", Description: "create an object in anytype"}, anytypeMcp.CreateObject)
+		addTool(mcpServer, cfg, &mcp.Tool{Name: "update-object", Description: "update name, markdown or properties of an object in anytype"}, anytypeMcp.UpdateObject)
+		addTool(mcpServer, cfg, &mcp.Tool{Name: "archive-object", Description: "archive an object in anytype"}, anytypeMcp.ArchiveObject)
+		addTool(mcpServer, cfg, &mcp.Tool{Name: "create-tag", Description: "create a tag of a select or multi_select property in anytype"}, anytypeMcp.CreateTag)
+		addTool(mcpServer, cfg, &mcp.Tool{Name: "update-tag", Description: "rename or recolor a tag in anytype"}, anytypeMcp.UpdateTag)
+		addTool(mcpServer, cfg, &mcp.Tool{Name: "delete-tag", Description: "delete a tag in anytype"}, anytypeMcp.DeleteTag)
+	}
+
+	mcpServer.AddPrompt(&mcp.Prompt{
+		Name:        "summarize-object",
+		Description: "summarize an object in anytype",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "objectId", Description: "the id of the object to summarize", Required: true},
+			{Name: "spaceId", Description: "the space id of the object"},
 		},
-	)
-	mcp.AddTool(server, &mcp.Tool{Name: "search", Description: "search objects in anytype"}, anytypeMcp.Search)
-	mcp.AddTool(server, &mcp.Tool{Name: "get-object", Description: "get an object from anytype"}, anytypeMcp.GetObject)
+	}, anytypeMcp.SummarizeObject)
+	mcpServer.AddPrompt(&mcp.Prompt{
+		Name:        "daily-review",
+		Description: "review the tasks modified today in anytype",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "spaceId", Description: "limit the review to the space"},
+			{Name: "type", Description: "the object type key to review, defaults to task"},
+		},
+	}, anytypeMcp.DailyReview)
+	mcpServer.AddPrompt(&mcp.Prompt{
+		Name:        "answer-from-notes",
+		Description: "answer a question from notes in anytype with citations",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "question", Description: "the question to answer", Required: true},
+			{Name: "query", Description: "the keywords to search the notes, defaults to the question"},
+			{Name: "spaceId", Description: "limit the search to the space"},
+		},
+	}, anytypeMcp.AnswerFromNotes)
 
-	if err := server.Run(context.Background(), &mcp.StdioTransport{}); err != nil {
-		log.Fatal(err)
+	mcpServer.AddResourceTemplate(&mcp.ResourceTemplate{
+		Name:        "object",
+		Title:       "Anytype Object",
+		Description: "the markdown of an object in anytype",
+		MIMEType:    "text/markdown",
+		URITemplate: server.ObjectURITemplate,
+	}, anytypeMcp.ReadObject)
+	mcpServer.AddReceivingMiddleware(anytypeMcp.ResourceListMiddleware)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if index != nil {
+		go index.Run(ctx, anytype, store, semantic.DefaultRefreshInterval, func(err error) {
+			log.Printf("refresh semantic index: %v", err)
+		})
 	}
+
+	if cfg.CacheTTL > 0 {
+		go reportCacheStats(ctx, anytype, cacheStatsInterval)
+	}
+
+	if poller != nil {
+		go poller.Run(ctx, func(ctx context.Context, uri string) error {
+			return mcpServer.ResourceUpdated(ctx, &mcp.ResourceUpdatedNotificationParams{URI: uri})
+		})
+	}
+
+	switch cfg.Transport {
+	case "stdio":
+		if err := mcpServer.Run(ctx, &mcp.StdioTransport{}); err != nil && !errors.Is(err, context.Canceled) {
+			log.Fatal(err)
+		}
+	case "http":
+		listener, err := net.Listen("tcp", cfg.Listen)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.Printf("serving MCP on http://%s/mcp (SSE on /sse)", listener.Addr())
+		if err := server.ListenAndServe(ctx, listener, server.NewHTTPHandler(mcpServer, cfg.AuthToken)); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// addTool registers the tool when it is enabled by the config.
+func addTool[In, Out any](s *mcp.Server, cfg *Config, tool *mcp.Tool, handler mcp.ToolHandlerFor[In, Out]) {
+	if !slices.Contains(toolNames, tool.Name) {
+		panic("tool " + tool.Name + " is not in the tool names")
+	}
+
+	if !cfg.ToolEnabled(tool.Name) {
+		return
+	}
+
+	mcp.AddTool(s, tool, handler)
+}
+
+// openSemanticIndex opens the semantic index with the configured embedder.
+func openSemanticIndex(cfg *Config) (*semantic.Index, error) {
+	var embedder semantic.Embedder = semantic.NewHashEmbedder(0)
+	if cfg.Embedder == "openai" {
+		embedder = semantic.NewOpenAIEmbedder(cfg.EmbeddingEndpoint, cfg.EmbeddingModel, cfg.EmbeddingApiKey)
+	}
+
+	return semantic.Open(cfg.SemanticIndex, embedder)
+}
+
+// reportCacheStats logs the cache stats periodically until the context is done, unchanged stats are skipped.
+func reportCacheStats(ctx context.Context, client *anytype.Anytype, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last anytype.CacheStats
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if stats := client.CacheStats(); stats != last {
+				logCacheStats(stats)
+				last = stats
+			}
+		}
+	}
+}
+
+func logCacheStats(stats anytype.CacheStats) {
+	log.Printf("cache stats: %d hits, %d misses, %d entries", stats.Hits, stats.Misses, stats.Entries)
 }
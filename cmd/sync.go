@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/elct9620/anytype-mcp-lite/pkg/mirror"
+)
+
+// runSync mirrors the objects of all spaces into the offline mirror file, and refreshes the semantic index from the mirror when enabled.
+func runSync(ctx context.Context, args []string, stdout io.Writer, getenv func(string) string, defaultPath string) error {
+	if path := getenv("ANYTYPE_MCP_CONFIG"); path != "" {
+		defaultPath = path
+	}
+
+	fs := flag.NewFlagSet("anytype-mcp-lite sync", flag.ContinueOnError)
+	configPath := fs.String("config", defaultPath, "the JSON config file (env: ANYTYPE_MCP_CONFIG)")
+	mirrorPath := fs.String("mirror", "", "the offline mirror file, defaults to the configured mirror")
+	full := fs.Bool("full", false, "fetch all objects instead of the objects modified since the last sync, and rebuild the semantic index")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, _, err := loadConfig(nil, withoutConfigEnv(getenv), *configPath)
+	if err != nil {
+		return err
+	}
+
+	if *mirrorPath != "" {
+		cfg.Mirror = *mirrorPath
+	}
+
+	if cfg.Mirror == "" {
+		return errors.New("no mirror file, use --mirror to set the path")
+	}
+
+	retry := anytype.DefaultRetryPolicy()
+	retry.MaxRetries = cfg.MaxRetries
+
+	client := anytype.New(
+		cfg.ApiKey,
+		anytype.WithApiServer(cfg.ApiServer),
+		anytype.WithApiVersion(cfg.ApiVersion),
+		anytype.WithTimeout(time.Duration(cfg.Timeout)),
+		anytype.WithRetry(retry),
+	)
+
+	store, err := mirror.Open(cfg.Mirror)
+	if err != nil {
+		return fmt.Errorf("mirror %s: %w", cfg.Mirror, err)
+	}
+
+	report, err := mirror.Sync(ctx, client, store, *full)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Synced %d objects in %d spaces to %s, removed %d objects\n", report.Objects, report.Spaces, cfg.Mirror, report.Removed)
+
+	if !cfg.Semantic || cfg.SemanticIndex == "" {
+		return nil
+	}
+
+	index, err := openSemanticIndex(cfg)
+	if err != nil {
+		return fmt.Errorf("semantic index %s: %w", cfg.SemanticIndex, err)
+	}
+
+	indexReport, err := index.Refresh(ctx, store, *full)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Indexed %d objects in %d spaces to %s, removed %d objects\n", indexReport.Objects, indexReport.Spaces, cfg.SemanticIndex, indexReport.Removed)
+
+	return nil
+}
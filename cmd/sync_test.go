@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/internal/anytypetest"
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/elct9620/anytype-mcp-lite/pkg/mirror"
+	"github.com/elct9620/anytype-mcp-lite/pkg/semantic"
+)
+
+func TestRunSync(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v1/spaces":
+			json.NewEncoder(w).Encode(anytype.ListSpacesOutput{Data: []anytype.Space{{ID: "space1", Name: "Work"}}})
+		case "/v1/spaces/space1/search":
+			json.NewEncoder(w).Encode(anytype.SearchOutput{Data: []anytype.Object{{ID: "obj1"}}})
+		case "/v1/spaces/space1/objects/obj1":
+			json.NewEncoder(w).Encode(anytype.GetObjectOutput{Object: anytype.Object{ID: "obj1", SpaceId: "space1", Name: "Roadmap"}})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "mirror.json")
+	env := map[string]string{"ANYTYPE_API_SERVER": server.URL, "ANYTYPE_API_KEY": "test-api-key"}
+	getenv := func(key string) string { return env[key] }
+
+	var stdout bytes.Buffer
+	err := runSync(context.Background(), []string{"--mirror", path}, &stdout, getenv, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "Synced 1 objects in 1 spaces to " + path + ", removed 0 objects\n"
+	if stdout.String() != expected {
+		t.Errorf("expected output %q, got %q", expected, stdout.String())
+	}
+
+	store, err := mirror.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open mirror: %v", err)
+	}
+
+	if object, _, ok := store.Object("space1", "obj1"); !ok || object.Name != "Roadmap" {
+		t.Errorf("expected object Roadmap mirrored, got %+v", object)
+	}
+}
+
+func TestRunSync_Semantic(t *testing.T) {
+	fake := &anytypetest.Server{}
+	fake.Add("obj1", "Roadmap", "Quarterly milestones", time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC))
+
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	dir := t.TempDir()
+	mirrorPath := filepath.Join(dir, "mirror.json")
+	path := filepath.Join(dir, "semantic-index.json")
+	env := map[string]string{
+		"ANYTYPE_API_SERVER":         server.URL,
+		"ANYTYPE_API_KEY":            "test-api-key",
+		"ANYTYPE_MCP_SEMANTIC":       "true",
+		"ANYTYPE_MCP_SEMANTIC_INDEX": path,
+	}
+	getenv := func(key string) string { return env[key] }
+
+	var stdout bytes.Buffer
+	err := runSync(context.Background(), []string{"--mirror", mirrorPath}, &stdout, getenv, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "Synced 1 objects in 1 spaces to " + mirrorPath + ", removed 0 objects\n" +
+		"Indexed 1 objects in 1 spaces to " + path + ", removed 0 objects\n"
+	if stdout.String() != expected {
+		t.Errorf("expected output %q, got %q", expected, stdout.String())
+	}
+
+	if fetched := fake.TakeFetched(); len(fetched) != 1 {
+		t.Errorf("expected the object fetched once for the mirror and the index, got %v", fetched)
+	}
+
+	index, err := semantic.Open(path, semantic.NewHashEmbedder(0))
+	if err != nil {
+		t.Fatalf("failed to open semantic index: %v", err)
+	}
+
+	if results, _ := index.Search(context.Background(), "milestones", "", 0); len(results) != 1 || results[0].ObjectId != "obj1" {
+		t.Errorf("expected object Roadmap indexed, got %+v", results)
+	}
+}
@@ -0,0 +1,101 @@
+// Package anytypetest provides a fake Anytype API for the tests which crawl the objects of a space.
+package anytypetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+)
+
+// SpaceId is the only space served by the fake.
+const SpaceId = "space1"
+
+// Server serves the objects of one space, the search is required to sort by last modified date in descending order.
+type Server struct {
+	mu      sync.Mutex
+	objects []anytype.Object
+	fetched []string
+}
+
+// Add puts the object at the front to keep the objects sorted by last modified date in descending order,
+// the object has no last modified date when modified is zero.
+func (s *Server) Add(id, name, markdown string, modified time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object := anytype.Object{ID: id, SpaceId: SpaceId, Name: name, Markdown: markdown}
+	if !modified.IsZero() {
+		object.Properties = []anytype.Property{
+			{Key: "last_modified_date", Format: "date", Date: modified.Format(time.RFC3339)},
+		}
+	}
+
+	s.objects = append([]anytype.Object{object}, s.objects...)
+}
+
+func (s *Server) Remove(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.objects = slices.DeleteFunc(s.objects, func(object anytype.Object) bool { return object.ID == id })
+}
+
+// TakeFetched returns the ids of the objects fetched since the last call.
+func (s *Server) TakeFetched() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fetched := s.fetched
+	s.fetched = nil
+	return fetched
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.URL.Path == "/v1/spaces":
+		json.NewEncoder(w).Encode(anytype.ListSpacesOutput{Data: []anytype.Space{{ID: SpaceId, Name: "Work"}}})
+	case r.URL.Path == "/v1/spaces/"+SpaceId+"/search":
+		var body anytype.SearchBody
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.Sort == nil || body.Sort.PropertyKey != "last_modified_date" || body.Sort.Direction != "desc" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// return one object per page to cover the pagination
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		var data []anytype.Object
+		if offset < len(s.objects) {
+			data = []anytype.Object{{ID: s.objects[offset].ID, Properties: s.objects[offset].Properties}}
+		}
+
+		json.NewEncoder(w).Encode(anytype.SearchOutput{
+			Data:       data,
+			Pagination: anytype.Pagination{Total: len(s.objects), Offset: offset, HasMore: offset+1 < len(s.objects)},
+		})
+	case strings.HasPrefix(r.URL.Path, "/v1/spaces/"+SpaceId+"/objects/"):
+		id := strings.TrimPrefix(r.URL.Path, "/v1/spaces/"+SpaceId+"/objects/")
+		s.fetched = append(s.fetched, id)
+		for _, object := range s.objects {
+			if object.ID == id {
+				json.NewEncoder(w).Encode(anytype.GetObjectOutput{Object: object})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
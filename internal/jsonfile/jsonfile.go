@@ -0,0 +1,107 @@
+// Package jsonfile keeps data in a JSON file shared by the server and the sync command.
+package jsonfile
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// File is a JSON file written atomically which is only readable by the user, nothing is read or written when the path is empty.
+// It is not safe for concurrent use, the caller guards it with the lock of the data.
+type File struct {
+	path    string
+	modTime time.Time
+}
+
+func New(path string) *File {
+	return &File{path: path}
+}
+
+// Load decodes the file into v, v is untouched when the file does not exist.
+func (f *File) Load(v any) error {
+	if f.path == "" {
+		return nil
+	}
+
+	info, err := os.Stat(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	f.modTime = info.ModTime()
+	return nil
+}
+
+// Changed reports whether the file is written by another process since the last load or save.
+func (f *File) Changed() (bool, error) {
+	if f.path == "" {
+		return false, nil
+	}
+
+	info, err := os.Stat(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return !info.ModTime().Equal(f.modTime), nil
+}
+
+// Save encodes v into a temporary file and renames it to the file, readers never see a partial write.
+func (f *File) Save(v any) error {
+	if f.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(f.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(dir, "."+filepath.Base(f.path)+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(file.Name(), f.path); err != nil {
+		return err
+	}
+
+	if info, err := os.Stat(f.path); err == nil {
+		f.modTime = info.ModTime()
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package jsonfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type data struct {
+	Name string `json:"name"`
+}
+
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "data.json")
+
+	file := New(path)
+	if err := file.Load(&data{}); err != nil {
+		t.Fatalf("expected missing file to be skipped, got %v", err)
+	}
+
+	if err := file.Save(data{Name: "first"}); err != nil {
+		t.Fatalf("failed to save file: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("expected file, got %v", err)
+	}
+
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("expected file permission 0600, got %o", perm)
+	}
+
+	if changed, err := file.Changed(); err != nil || changed {
+		t.Errorf("expected file saved by itself unchanged, got %v, %v", changed, err)
+	}
+
+	reader := New(path)
+	var loaded data
+	if err := reader.Load(&loaded); err != nil {
+		t.Fatalf("failed to load file: %v", err)
+	}
+
+	if loaded.Name != "first" {
+		t.Errorf("expected name first, got %q", loaded.Name)
+	}
+
+	if err := file.Save(data{Name: "second"}); err != nil {
+		t.Fatalf("failed to save file: %v", err)
+	}
+
+	// make sure the modification time is changed on file systems with coarse timestamps
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, future, future); err != nil {
+		t.Fatalf("failed to change modification time: %v", err)
+	}
+
+	if changed, err := reader.Changed(); err != nil || !changed {
+		t.Errorf("expected file written by another writer changed, got %v, %v", changed, err)
+	}
+}
+
+func TestFile_EmptyPath(t *testing.T) {
+	file := New("")
+	if err := file.Save(data{Name: "memory"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var loaded data
+	if err := file.Load(&loaded); err != nil || loaded.Name != "" {
+		t.Errorf("expected nothing loaded, got %+v, %v", loaded, err)
+	}
+
+	if changed, err := file.Changed(); err != nil || changed {
+		t.Errorf("expected unchanged, got %v, %v", changed, err)
+	}
+}
@@ -4,7 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 const APIVersion = "2025-05-20"
@@ -19,7 +24,7 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req.Header.Add("Anytype-Version", t.apiVersion)
 	req.Header.Add("Authorization", "Bearer "+t.apiKey)
 	req.Header.Add("Accept", "application/json")
-	if req.Method == http.MethodPost {
+	if req.Body != nil {
 		req.Header.Add("Content-Type", "application/json")
 	}
 	return http.DefaultTransport.RoundTrip(req)
@@ -28,6 +33,9 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 type Anytype struct {
 	apiServer  string
 	httpClient *http.Client
+	retry      RetryPolicy
+	limiter    *rateLimiter
+	cache      *cache
 }
 
 type AnytypeOption func(*Anytype)
@@ -56,54 +64,156 @@ func WithApiServer(apiServer string) AnytypeOption {
 	}
 }
 
+// WithApiVersion overrides the Anytype-Version header sent with each request.
+func WithApiVersion(apiVersion string) AnytypeOption {
+	return func(a *Anytype) {
+		if transport, ok := a.httpClient.Transport.(*Transport); ok {
+			transport.apiVersion = apiVersion
+		}
+	}
+}
+
+// WithTimeout limits the time of each request including reading the response, zero means no timeout.
+func WithTimeout(timeout time.Duration) AnytypeOption {
+	return func(a *Anytype) {
+		a.httpClient.Timeout = timeout
+	}
+}
+
 func (a *Anytype) Get(ctx context.Context, path string, result any) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.apiServer+path, nil)
-	if err != nil {
-		return err
+	return a.do(ctx, http.MethodGet, path, nil, result)
+}
+
+func (a *Anytype) Post(ctx context.Context, path string, payload any, result any) error {
+	return a.do(ctx, http.MethodPost, path, payload, result)
+}
+
+func (a *Anytype) Patch(ctx context.Context, path string, payload any, result any) error {
+	return a.do(ctx, http.MethodPatch, path, payload, result)
+}
+
+func (a *Anytype) Delete(ctx context.Context, path string, result any) error {
+	return a.do(ctx, http.MethodDelete, path, nil, result)
+}
+
+func (a *Anytype) do(ctx context.Context, method, path string, payload any, result any) error {
+	var data []byte
+	if payload != nil {
+		var err error
+		data, err = json.Marshal(payload)
+		if err != nil {
+			return err
+		}
 	}
 
-	resp, err := a.httpClient.Do(req)
+	idempotent := isIdempotent(ctx, method)
+	isWrite := method != http.MethodGet && (method != http.MethodPost || !idempotent)
+
+	key := cacheKey(method, path, data)
+	if a.cache != nil && !isWrite && !bypassCache(ctx) {
+		if body, ok := a.cache.get(key); ok {
+			return json.Unmarshal(body, result)
+		}
+	}
+
+	body, err := a.request(ctx, method, path, data, idempotent)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		var errResp Error
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return err
+	if a.cache != nil {
+		if isWrite {
+			a.cache.invalidate(method, path)
+		} else {
+			a.cache.set(key, path, body)
 		}
-
-		return &errResp
 	}
 
-	return json.NewDecoder(resp.Body).Decode(result)
+	return json.Unmarshal(body, result)
 }
 
-func (a *Anytype) Post(ctx context.Context, path string, payload any, result any) error {
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return err
+// request sends the request with retry and rate limit, and returns the response body.
+func (a *Anytype) request(ctx context.Context, method, path string, data []byte, idempotent bool) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		if a.limiter != nil {
+			if err := a.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		body, status, retryAfter, err := a.send(ctx, method, path, data)
+		if err == nil {
+			return body, nil
+		}
+
+		if attempt >= a.retry.MaxRetries || !retryable(ctx, status, err, idempotent) {
+			return nil, err
+		}
+
+		if a.retry.MaxDelay > 0 && retryAfter > a.retry.MaxDelay {
+			return nil, err
+		}
+
+		if sleepErr := sleep(ctx, a.retry.backoff(attempt, retryAfter)); sleepErr != nil {
+			return nil, err
+		}
+	}
+}
+
+// send makes a single attempt, the status is zero when no response is received.
+func (a *Anytype) send(ctx context.Context, method, path string, data []byte) ([]byte, int, time.Duration, error) {
+	var body io.Reader
+	if data != nil {
+		body = bytes.NewReader(data)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.apiServer+path, bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, method, a.apiServer+path, body)
 	if err != nil {
-		return err
+		return nil, 0, 0, err
 	}
 
 	resp, err := a.httpClient.Do(req)
 	if err != nil {
-		return err
+		if ctx.Err() == nil {
+			err = &unavailableError{err: err}
+		}
+		return nil, 0, 0, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		var errResp Error
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return err
-		}
-		return &errResp
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, resp.StatusCode, parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()), newError(req, resp)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	return respBody, resp.StatusCode, 0, err
+}
+
+// newError decodes the error response, the body is kept as is when it is not JSON.
+func newError(req *http.Request, resp *http.Response) *Error {
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody+1))
+
+	var errResp Error
+	_ = json.Unmarshal(data, &errResp)
+
+	errResp.Status = resp.StatusCode
+	errResp.Method = req.Method
+	errResp.Path = req.URL.Path
+	errResp.Body = string(data)
+	if len(data) > maxErrorBody {
+		errResp.Body = strings.ToValidUTF8(string(data[:maxErrorBody]), "") + "..."
+	}
+
+	return &errResp
+}
+
+// paginate encodes the pagination query parameters, the limit is omitted to use server default when it is zero.
+func paginate(offset, limit int) string {
+	params := url.Values{}
+	params.Add("offset", strconv.Itoa(offset))
+	if limit > 0 {
+		params.Add("limit", strconv.Itoa(limit))
 	}
 
-	return json.NewDecoder(resp.Body).Decode(result)
+	return params.Encode()
 }
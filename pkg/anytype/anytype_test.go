@@ -0,0 +1,46 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithApiVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Anytype-Version") != "2025-11-08" {
+			t.Errorf("expected Anytype-Version header 2025-11-08, got %s", r.Header.Get("Anytype-Version"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(GetSpaceOutput{})
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL), WithApiVersion("2025-11-08"))
+	_, err := client.GetSpace(context.Background(), GetSpaceInput{Params: GetSpaceParams{SpaceId: "space1"}})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL), WithTimeout(10*time.Millisecond))
+	_, err := client.GetSpace(context.Background(), GetSpaceInput{Params: GetSpaceParams{SpaceId: "space1"}})
+
+	if err == nil {
+		t.Fatal("expected timeout error, got nil")
+	}
+}
@@ -0,0 +1,52 @@
+package anytype
+
+import "context"
+
+type CreateChallengeBody struct {
+	AppName string `json:"app_name"`
+}
+
+type CreateChallengeInput struct {
+	Body CreateChallengeBody `json:"body"`
+}
+
+type CreateChallengeOutput struct {
+	ChallengeId string `json:"challenge_id"`
+}
+
+// CreateChallenge asks the Anytype desktop app to display a 4-digit code for pairing.
+func (a *Anytype) CreateChallenge(ctx context.Context, input CreateChallengeInput) (*CreateChallengeOutput, error) {
+	var output CreateChallengeOutput
+
+	err := a.Post(ctx, "/v1/auth/challenges", input.Body, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+type CreateApiKeyBody struct {
+	ChallengeId string `json:"challenge_id"`
+	Code        string `json:"code"`
+}
+
+type CreateApiKeyInput struct {
+	Body CreateApiKeyBody `json:"body"`
+}
+
+type CreateApiKeyOutput struct {
+	ApiKey string `json:"api_key"`
+}
+
+// CreateApiKey exchanges the challenge and the code displayed in Anytype for an API key.
+func (a *Anytype) CreateApiKey(ctx context.Context, input CreateApiKeyInput) (*CreateApiKeyOutput, error) {
+	var output CreateApiKeyOutput
+
+	err := a.Post(ctx, "/v1/auth/api_keys", input.Body, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
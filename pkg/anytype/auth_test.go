@@ -0,0 +1,108 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateChallenge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/v1/auth/challenges" {
+			t.Errorf("expected path /v1/auth/challenges, got %s", r.URL.Path)
+		}
+
+		var body CreateChallengeBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+
+		if body.AppName != "anytype-mcp-lite" {
+			t.Errorf("expected app name anytype-mcp-lite, got %s", body.AppName)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(CreateChallengeOutput{ChallengeId: "challenge1"})
+	}))
+	defer server.Close()
+
+	client := New("", WithApiServer(server.URL))
+	result, err := client.CreateChallenge(context.Background(), CreateChallengeInput{
+		Body: CreateChallengeBody{AppName: "anytype-mcp-lite"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.ChallengeId != "challenge1" {
+		t.Errorf("expected challenge id challenge1, got %s", result.ChallengeId)
+	}
+}
+
+func TestCreateApiKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		code        string
+		expectedKey string
+		expectError bool
+	}{
+		{"valid code", "1234", "api-key-1", false},
+		{"invalid code", "0000", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/auth/api_keys" {
+					t.Errorf("expected path /v1/auth/api_keys, got %s", r.URL.Path)
+				}
+
+				var body CreateApiKeyBody
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatalf("failed to decode request body: %v", err)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				if body.ChallengeId != "challenge1" || body.Code != "1234" {
+					w.WriteHeader(http.StatusBadRequest)
+					json.NewEncoder(w).Encode(Error{Code: "bad_request", Message: "Invalid code", Object: "error", Status: 400})
+					return
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(CreateApiKeyOutput{ApiKey: "api-key-1"})
+			}))
+			defer server.Close()
+
+			client := New("", WithApiServer(server.URL))
+			result, err := client.CreateApiKey(context.Background(), CreateApiKeyInput{
+				Body: CreateApiKeyBody{ChallengeId: "challenge1", Code: tt.code},
+			})
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if result.ApiKey != tt.expectedKey {
+				t.Errorf("expected api key %s, got %s", tt.expectedKey, result.ApiKey)
+			}
+		})
+	}
+}
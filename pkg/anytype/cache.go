@@ -0,0 +1,190 @@
+package anytype
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheSize is the maximum cached responses when the size is not given.
+const DefaultCacheSize = 256
+
+// CacheStats is the cache usage for diagnostics.
+type CacheStats struct {
+	Hits    int64 `json:"hits"`
+	Misses  int64 `json:"misses"`
+	Entries int   `json:"entries"`
+}
+
+// WithCache caches read responses in memory up to size entries for ttl, zero ttl disables the cache.
+// Writes through the client invalidate the cached responses of the changed object, its list and the searches in the space.
+func WithCache(ttl time.Duration, size int) AnytypeOption {
+	return func(a *Anytype) {
+		if ttl <= 0 {
+			a.cache = nil
+			return
+		}
+
+		a.cache = newCache(ttl, size)
+	}
+}
+
+type noCacheKey struct{}
+
+// WithoutCache makes requests with the context skip the cached responses, the fresh response is still cached.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+func bypassCache(ctx context.Context) bool {
+	bypass, _ := ctx.Value(noCacheKey{}).(bool)
+	return bypass
+}
+
+// CacheStats returns the cache usage, it is zero when the cache is disabled.
+func (a *Anytype) CacheStats() CacheStats {
+	if a.cache == nil {
+		return CacheStats{}
+	}
+
+	return a.cache.stats()
+}
+
+func cacheKey(method, path string, body []byte) string {
+	return method + " " + path + "\n" + string(body)
+}
+
+type cacheEntry struct {
+	key     string
+	path    string
+	body    []byte
+	expires time.Time
+}
+
+// cache is a LRU cache with TTL of the raw response bodies.
+type cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+	hits    int64
+	misses  int64
+	now     func() time.Time
+}
+
+func newCache(ttl time.Duration, size int) *cache {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+
+	return &cache{
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+func (c *cache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+
+	entry := element.Value.(*cacheEntry)
+	if c.now().After(entry.expires) {
+		c.remove(element)
+		c.misses++
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	c.hits++
+	return entry.body, true
+}
+
+func (c *cache) set(key, path string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry{key: key, path: stripQuery(path), body: body, expires: c.now().Add(c.ttl)}
+	if element, ok := c.entries[key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// invalidate removes the responses may be changed by the write request.
+func (c *cache) invalidate(method, path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, element := range c.entries {
+		if affected(method, stripQuery(path), element.Value.(*cacheEntry).path) {
+			c.remove(element)
+		}
+	}
+}
+
+func (c *cache) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*cacheEntry).key)
+}
+
+func (c *cache) stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return CacheStats{Hits: c.hits, Misses: c.misses, Entries: c.order.Len()}
+}
+
+// affected reports whether the cached path may be changed by the write, the creation only changes its collection
+// and the update or delete changes the resource, its sub resources and its collection. Searches and lists in the same space are always affected.
+func affected(method, written, cached string) bool {
+	parent := written[:max(strings.LastIndex(written, "/"), 0)]
+
+	switch {
+	case cached == written:
+		return true
+	case method != "POST" && (strings.HasPrefix(cached, written+"/") || cached == parent):
+		return true
+	case cached == "/v1/search":
+		return true
+	}
+
+	space := spacePath(written)
+	return space != "" && strings.HasPrefix(cached, space+"/") &&
+		(strings.HasSuffix(cached, "/search") || strings.Contains(cached, "/lists/"))
+}
+
+// spacePath returns the /v1/spaces/{id} prefix of the path.
+func spacePath(path string) string {
+	parts := strings.SplitN(path, "/", 5)
+	if len(parts) < 4 || parts[1] != "v1" || parts[2] != "spaces" {
+		return ""
+	}
+
+	return strings.Join(parts[:4], "/")
+}
+
+func stripQuery(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		return path[:i]
+	}
+
+	return path
+}
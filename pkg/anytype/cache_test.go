@@ -0,0 +1,228 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newCountingServer() (*httptest.Server, func(key string) int) {
+	var mu sync.Mutex
+	calls := make(map[string]int)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls[r.Method+" "+r.URL.Path]++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"space":  Space{ID: "space1"},
+			"object": Object{ID: "obj1", SpaceId: "space1"},
+			"data":   []Object{{ID: "obj1"}},
+		})
+	}))
+
+	return server, func(key string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls[key]
+	}
+}
+
+func TestWithCache(t *testing.T) {
+	server, calls := newCountingServer()
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL), WithCache(time.Minute, 10))
+	ctx := context.Background()
+	input := GetObjectInput{Params: GetObjectParams{SpaceId: "space1", ObjectId: "obj1"}}
+
+	for range 3 {
+		res, err := client.GetObject(ctx, input)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if res.Object.ID != "obj1" {
+			t.Errorf("expected object obj1, got %s", res.Object.ID)
+		}
+	}
+
+	if n := calls("GET /v1/spaces/space1/objects/obj1"); n != 1 {
+		t.Errorf("expected 1 request, got %d", n)
+	}
+
+	if _, err := client.GetObject(WithoutCache(ctx), input); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if n := calls("GET /v1/spaces/space1/objects/obj1"); n != 2 {
+		t.Errorf("expected cache bypassed, got %d requests", n)
+	}
+
+	expected := CacheStats{Hits: 2, Misses: 1, Entries: 1}
+	if stats := client.CacheStats(); stats != expected {
+		t.Errorf("expected stats %+v, got %+v", expected, stats)
+	}
+}
+
+func TestWithCache_Search(t *testing.T) {
+	server, calls := newCountingServer()
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL), WithCache(time.Minute, 10))
+	ctx := context.Background()
+
+	for _, query := range []string{"note", "note", "task"} {
+		_, err := client.Search(ctx, SearchInput{Params: SearchParams{SpaceId: "space1"}, Body: SearchBody{Query: query}})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	if n := calls("POST /v1/spaces/space1/search"); n != 2 {
+		t.Errorf("expected searches cached by body, got %d requests", n)
+	}
+}
+
+func TestWithCache_Invalidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(context.Context, *Anytype) error
+	}{
+		{
+			name: "update object",
+			write: func(ctx context.Context, a *Anytype) error {
+				_, err := a.UpdateObject(ctx, UpdateObjectInput{Params: UpdateObjectParams{SpaceId: "space1", ObjectId: "obj1"}})
+				return err
+			},
+		},
+		{
+			name: "delete object",
+			write: func(ctx context.Context, a *Anytype) error {
+				_, err := a.DeleteObject(ctx, DeleteObjectInput{Params: DeleteObjectParams{SpaceId: "space1", ObjectId: "obj1"}})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, calls := newCountingServer()
+			defer server.Close()
+
+			client := New("test-api-key", WithApiServer(server.URL), WithCache(time.Minute, 10))
+			ctx := context.Background()
+
+			read := func() {
+				if _, err := client.GetObject(ctx, GetObjectInput{Params: GetObjectParams{SpaceId: "space1", ObjectId: "obj1"}}); err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+
+				if _, err := client.Search(ctx, SearchInput{Params: SearchParams{SpaceId: "space1"}}); err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+
+				if _, err := client.GetSpace(ctx, GetSpaceInput{Params: GetSpaceParams{SpaceId: "space1"}}); err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+			}
+
+			read()
+			if err := tt.write(ctx, client); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			read()
+
+			if n := calls("GET /v1/spaces/space1/objects/obj1"); n != 2 {
+				t.Errorf("expected object refetched after write, got %d requests", n)
+			}
+
+			if n := calls("POST /v1/spaces/space1/search"); n != 2 {
+				t.Errorf("expected search refetched after write, got %d requests", n)
+			}
+
+			if n := calls("GET /v1/spaces/space1"); n != 1 {
+				t.Errorf("expected space kept in cache, got %d requests", n)
+			}
+		})
+	}
+}
+
+func TestCache_Expiration(t *testing.T) {
+	now := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+	c := newCache(time.Minute, 10)
+	c.now = func() time.Time { return now }
+
+	c.set("key", "/v1/spaces/space1", []byte("{}"))
+	if _, ok := c.get("key"); !ok {
+		t.Fatal("expected cached entry")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := c.get("key"); ok {
+		t.Error("expected expired entry to be missed")
+	}
+
+	if stats := c.stats(); stats.Entries != 0 {
+		t.Errorf("expected expired entry removed, got %d entries", stats.Entries)
+	}
+}
+
+func TestCache_Eviction(t *testing.T) {
+	c := newCache(time.Minute, 2)
+
+	c.set("a", "/a", []byte("a"))
+	c.set("b", "/b", []byte("b"))
+	c.get("a")
+	c.set("c", "/c", []byte("c"))
+
+	if _, ok := c.get("b"); ok {
+		t.Error("expected least recently used entry evicted")
+	}
+
+	for _, key := range []string{"a", "c"} {
+		if _, ok := c.get(key); !ok {
+			t.Errorf("expected entry %s cached", key)
+		}
+	}
+}
+
+func TestAffected(t *testing.T) {
+	tests := []struct {
+		method   string
+		written  string
+		cached   string
+		expected bool
+	}{
+		{"PATCH", "/v1/spaces/s1/objects/o1", "/v1/spaces/s1/objects/o1", true},
+		{"PATCH", "/v1/spaces/s1/objects/o1", "/v1/spaces/s1/objects", true},
+		{"PATCH", "/v1/spaces/s1/objects/o1", "/v1/spaces/s1/objects/o2", false},
+		{"PATCH", "/v1/spaces/s1/objects/o1", "/v1/spaces/s1/search", true},
+		{"PATCH", "/v1/spaces/s1/objects/o1", "/v1/spaces/s2/search", false},
+		{"PATCH", "/v1/spaces/s1/objects/o1", "/v1/search", true},
+		{"PATCH", "/v1/spaces/s1/objects/o1", "/v1/spaces/s1/lists/l1/views/v1/objects", true},
+		{"PATCH", "/v1/spaces/s1/objects/o1", "/v1/spaces/s1/types", false},
+		{"DELETE", "/v1/spaces/s1/properties/p1/tags/t1", "/v1/spaces/s1/properties/p1/tags", true},
+		{"POST", "/v1/spaces/s1/objects", "/v1/spaces/s1/objects", true},
+		{"POST", "/v1/spaces/s1/objects", "/v1/spaces/s1/objects/o1", false},
+		{"POST", "/v1/spaces/s1/objects", "/v1/spaces", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.written+" "+tt.cached, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := affected(tt.method, tt.written, tt.cached); actual != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -1,17 +1,45 @@
 package anytype
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	ErrNotFound          = errors.New("not found")
+	ErrUnauthorized      = errors.New("unauthorized")
+	ErrRateLimited       = errors.New("rate limited")
+	ErrServerUnavailable = errors.New("server unavailable")
+)
+
+// maxErrorBody limits the raw response body kept in the error.
+const maxErrorBody = 512
 
 var _ error = &Error{}
 
+// Error is returned for non-2xx responses, the Status, Method, Path and Body are always from the HTTP response.
 type Error struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
 	Object  string `json:"object,omitempty"`
 	Status  int    `json:"status,omitempty"`
+	Method  string `json:"-"`
+	Path    string `json:"-"`
+	Body    string `json:"-"`
 }
 
 func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf(
+			"%s %s returned an error: %s (status: %d)",
+			e.Method,
+			e.Path,
+			e.Body,
+			e.Status,
+		)
+	}
+
 	return fmt.Sprintf(
 		"The object %s returned an error: %s (code: %s, status: %d)",
 		e.Object,
@@ -20,3 +48,36 @@ func (e *Error) Error() string {
 		e.Status,
 	)
 }
+
+// Is matches the sentinel errors by HTTP status.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Status == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.Status == http.StatusUnauthorized
+	case ErrRateLimited:
+		return e.Status == http.StatusTooManyRequests
+	case ErrServerUnavailable:
+		return e.Status == http.StatusBadGateway || e.Status == http.StatusServiceUnavailable || e.Status == http.StatusGatewayTimeout
+	}
+
+	return false
+}
+
+// unavailableError marks network errors when the Anytype is not reachable, e.g. the desktop app is not running.
+type unavailableError struct {
+	err error
+}
+
+func (e *unavailableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *unavailableError) Is(target error) bool {
+	return target == ErrServerUnavailable
+}
+
+func (e *unavailableError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,127 @@
+package anytype
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestError_Is(t *testing.T) {
+	sentinels := []error{ErrNotFound, ErrUnauthorized, ErrRateLimited, ErrServerUnavailable}
+
+	tests := []struct {
+		status   int
+		expected error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusBadGateway, ErrServerUnavailable},
+		{http.StatusServiceUnavailable, ErrServerUnavailable},
+		{http.StatusGatewayTimeout, ErrServerUnavailable},
+		{http.StatusBadRequest, nil},
+		{http.StatusInternalServerError, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			t.Parallel()
+
+			err := error(&Error{Status: tt.status})
+			for _, sentinel := range sentinels {
+				if errors.Is(err, sentinel) != (sentinel == tt.expected) {
+					t.Errorf("expected errors.Is(%v) to be %v", sentinel, sentinel == tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestError_Response(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          int
+		body            string
+		expectedMessage string
+		expectedBody    string
+		expectedError   string
+	}{
+		{
+			name:            "json error",
+			status:          http.StatusNotFound,
+			body:            `{"code":"not_found","message":"Space not found","object":"space","status":200}`,
+			expectedMessage: "Space not found",
+			expectedBody:    `{"code":"not_found","message":"Space not found","object":"space","status":200}`,
+			expectedError:   "The object space returned an error: Space not found (code: not_found, status: 404)",
+		},
+		{
+			name:          "plain text error",
+			status:        http.StatusBadGateway,
+			body:          "upstream is down",
+			expectedBody:  "upstream is down",
+			expectedError: "GET /v1/spaces/space1 returned an error: upstream is down (status: 502)",
+		},
+		{
+			name:          "truncated body",
+			status:        http.StatusInternalServerError,
+			body:          strings.Repeat("a", maxErrorBody+10),
+			expectedBody:  strings.Repeat("a", maxErrorBody) + "...",
+			expectedError: "GET /v1/spaces/space1 returned an error: " + strings.Repeat("a", maxErrorBody) + "... (status: 500)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := New("test-api-key", WithApiServer(server.URL))
+			_, err := client.GetSpace(context.Background(), GetSpaceInput{Params: GetSpaceParams{SpaceId: "space1"}})
+
+			var apiErr *Error
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *Error, got %v", err)
+			}
+
+			if apiErr.Status != tt.status {
+				t.Errorf("expected status %d, got %d", tt.status, apiErr.Status)
+			}
+
+			if apiErr.Method != http.MethodGet || apiErr.Path != "/v1/spaces/space1" {
+				t.Errorf("expected GET /v1/spaces/space1, got %s %s", apiErr.Method, apiErr.Path)
+			}
+
+			if apiErr.Message != tt.expectedMessage {
+				t.Errorf("expected message %q, got %q", tt.expectedMessage, apiErr.Message)
+			}
+
+			if apiErr.Body != tt.expectedBody {
+				t.Errorf("expected body %q, got %q", tt.expectedBody, apiErr.Body)
+			}
+
+			if err.Error() != tt.expectedError {
+				t.Errorf("expected error %q, got %q", tt.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestError_ServerUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	_, err := client.GetSpace(context.Background(), GetSpaceInput{Params: GetSpaceParams{SpaceId: "space1"}})
+
+	if !errors.Is(err, ErrServerUnavailable) {
+		t.Errorf("expected ErrServerUnavailable, got %v", err)
+	}
+}
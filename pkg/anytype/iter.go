@@ -0,0 +1,176 @@
+package anytype
+
+import (
+	"context"
+	"iter"
+)
+
+// pages yields the items page by page from the offset until no more pages or the max items is reached, zero max items means no limit.
+// The iteration stops after yielding an error.
+func pages[T any](ctx context.Context, offset, maxItems int, fetch func(offset int) ([]T, Pagination, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		count := 0
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			data, pagination, err := fetch(offset)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range data {
+				if !yield(item, nil) {
+					return
+				}
+
+				// Stop before fetching another page when the max items land on a page boundary.
+				count++
+				if maxItems > 0 && count >= maxItems {
+					return
+				}
+			}
+
+			offset += len(data)
+			if !pagination.HasMore || len(data) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// Collect gathers the items of the iterator until the first error.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// SearchAll iterates the search results from the offset of the input, zero max items means no limit.
+func (a *Anytype) SearchAll(ctx context.Context, input SearchInput, maxItems int) iter.Seq2[Object, error] {
+	return pages(ctx, input.Params.Offset, maxItems, func(offset int) ([]Object, Pagination, error) {
+		input.Params.Offset = offset
+		res, err := a.Search(ctx, input)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return res.Data, res.Pagination, nil
+	})
+}
+
+// ListSpacesAll iterates the spaces from the offset of the input, zero max items means no limit.
+func (a *Anytype) ListSpacesAll(ctx context.Context, input ListSpacesInput, maxItems int) iter.Seq2[Space, error] {
+	return pages(ctx, input.Params.Offset, maxItems, func(offset int) ([]Space, Pagination, error) {
+		input.Params.Offset = offset
+		res, err := a.ListSpaces(ctx, input)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return res.Data, res.Pagination, nil
+	})
+}
+
+// ListTypesAll iterates the types from the offset of the input, zero max items means no limit.
+func (a *Anytype) ListTypesAll(ctx context.Context, input ListTypesInput, maxItems int) iter.Seq2[ObjectType, error] {
+	return pages(ctx, input.Params.Offset, maxItems, func(offset int) ([]ObjectType, Pagination, error) {
+		input.Params.Offset = offset
+		res, err := a.ListTypes(ctx, input)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return res.Data, res.Pagination, nil
+	})
+}
+
+// ListMembersAll iterates the members from the offset of the input, zero max items means no limit.
+func (a *Anytype) ListMembersAll(ctx context.Context, input ListMembersInput, maxItems int) iter.Seq2[Member, error] {
+	return pages(ctx, input.Params.Offset, maxItems, func(offset int) ([]Member, Pagination, error) {
+		input.Params.Offset = offset
+		res, err := a.ListMembers(ctx, input)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return res.Data, res.Pagination, nil
+	})
+}
+
+// ListTemplatesAll iterates the templates from the offset of the input, zero max items means no limit.
+func (a *Anytype) ListTemplatesAll(ctx context.Context, input ListTemplatesInput, maxItems int) iter.Seq2[Object, error] {
+	return pages(ctx, input.Params.Offset, maxItems, func(offset int) ([]Object, Pagination, error) {
+		input.Params.Offset = offset
+		res, err := a.ListTemplates(ctx, input)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return res.Data, res.Pagination, nil
+	})
+}
+
+// ListPropertiesAll iterates the properties from the offset of the input, zero max items means no limit.
+func (a *Anytype) ListPropertiesAll(ctx context.Context, input ListPropertiesInput, maxItems int) iter.Seq2[PropertyDefinition, error] {
+	return pages(ctx, input.Params.Offset, maxItems, func(offset int) ([]PropertyDefinition, Pagination, error) {
+		input.Params.Offset = offset
+		res, err := a.ListProperties(ctx, input)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return res.Data, res.Pagination, nil
+	})
+}
+
+// ListTagsAll iterates the tags from the offset of the input, zero max items means no limit.
+func (a *Anytype) ListTagsAll(ctx context.Context, input ListTagsInput, maxItems int) iter.Seq2[Tag, error] {
+	return pages(ctx, input.Params.Offset, maxItems, func(offset int) ([]Tag, Pagination, error) {
+		input.Params.Offset = offset
+		res, err := a.ListTags(ctx, input)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return res.Data, res.Pagination, nil
+	})
+}
+
+// ListViewsAll iterates the views from the offset of the input, zero max items means no limit.
+func (a *Anytype) ListViewsAll(ctx context.Context, input ListViewsInput, maxItems int) iter.Seq2[View, error] {
+	return pages(ctx, input.Params.Offset, maxItems, func(offset int) ([]View, Pagination, error) {
+		input.Params.Offset = offset
+		res, err := a.ListViews(ctx, input)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return res.Data, res.Pagination, nil
+	})
+}
+
+// ListViewObjectsAll iterates the objects of the view from the offset of the input, zero max items means no limit.
+func (a *Anytype) ListViewObjectsAll(ctx context.Context, input ListViewObjectsInput, maxItems int) iter.Seq2[Object, error] {
+	return pages(ctx, input.Params.Offset, maxItems, func(offset int) ([]Object, Pagination, error) {
+		input.Params.Offset = offset
+		res, err := a.ListViewObjects(ctx, input)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return res.Data, res.Pagination, nil
+	})
+}
@@ -0,0 +1,252 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// newPagedServer serves the items with the offset and limit query, the request of failAt offset returns an error.
+func newPagedServer(t *testing.T, path string, total, failAt int) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if r.URL.Path != path {
+			t.Errorf("expected path %s, got %s", path, r.URL.Path)
+		}
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if limit == 0 {
+			limit = 2
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if offset == failAt {
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(Error{Code: "internal_error", Message: "failed", Status: 500})
+			return
+		}
+
+		var data []map[string]string
+		for i := offset; i < min(offset+limit, total); i++ {
+			data = append(data, map[string]string{"id": "item" + strconv.Itoa(i)})
+		}
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"data":       data,
+			"pagination": Pagination{Total: total, Offset: offset, Limit: limit, HasMore: offset+limit < total},
+		})
+	}))
+
+	return server, &requests
+}
+
+func TestSearchAll(t *testing.T) {
+	tests := []struct {
+		name             string
+		params           SearchParams
+		maxItems         int
+		expectedIds      []string
+		expectedRequests int32
+	}{
+		{
+			name:             "all pages",
+			expectedIds:      []string{"item0", "item1", "item2", "item3", "item4"},
+			expectedRequests: 3,
+		},
+		{
+			name:             "from offset",
+			params:           SearchParams{Offset: 3},
+			expectedIds:      []string{"item3", "item4"},
+			expectedRequests: 1,
+		},
+		{
+			name:             "custom page size",
+			params:           SearchParams{Limit: 3},
+			expectedIds:      []string{"item0", "item1", "item2", "item3", "item4"},
+			expectedRequests: 2,
+		},
+		{
+			name:             "max items",
+			maxItems:         3,
+			expectedIds:      []string{"item0", "item1", "item2"},
+			expectedRequests: 2,
+		},
+		{
+			name:             "max items on page boundary",
+			maxItems:         2,
+			expectedIds:      []string{"item0", "item1"},
+			expectedRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, requests := newPagedServer(t, "/v1/search", 5, -1)
+			defer server.Close()
+
+			client := New("test-api-key", WithApiServer(server.URL))
+			objects, err := Collect(client.SearchAll(context.Background(), SearchInput{Params: tt.params}, tt.maxItems))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			ids := make([]string, len(objects))
+			for i, object := range objects {
+				ids[i] = object.ID
+			}
+
+			if !reflect.DeepEqual(ids, tt.expectedIds) {
+				t.Errorf("expected ids %v, got %v", tt.expectedIds, ids)
+			}
+
+			if requests.Load() != tt.expectedRequests {
+				t.Errorf("expected %d requests, got %d", tt.expectedRequests, requests.Load())
+			}
+		})
+	}
+}
+
+func TestSearchAll_Break(t *testing.T) {
+	server, requests := newPagedServer(t, "/v1/search", 5, -1)
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	for object, err := range client.SearchAll(context.Background(), SearchInput{}, 0) {
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if object.ID == "item0" {
+			break
+		}
+	}
+
+	if requests.Load() != 1 {
+		t.Errorf("expected no more pages fetched after break, got %d requests", requests.Load())
+	}
+}
+
+func TestSearchAll_Error(t *testing.T) {
+	server, _ := newPagedServer(t, "/v1/search", 5, 2)
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+
+	var ids []string
+	var lastErr error
+	for object, err := range client.SearchAll(context.Background(), SearchInput{}, 0) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		ids = append(ids, object.ID)
+	}
+
+	if lastErr == nil {
+		t.Fatal("expected error of the failed page")
+	}
+
+	if !reflect.DeepEqual(ids, []string{"item0", "item1"}) {
+		t.Errorf("expected items before the failed page, got %v", ids)
+	}
+}
+
+func TestSearchAll_ContextCanceled(t *testing.T) {
+	server, requests := newPagedServer(t, "/v1/search", 5, -1)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+
+	var lastErr error
+	for object, err := range client.SearchAll(ctx, SearchInput{}, 0) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+
+		if object.ID == "item1" {
+			cancel()
+		}
+	}
+
+	if lastErr != context.Canceled {
+		t.Errorf("expected context canceled error, got %v", lastErr)
+	}
+
+	if requests.Load() != 1 {
+		t.Errorf("expected no more pages fetched after cancel, got %d requests", requests.Load())
+	}
+}
+
+func TestListAll(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  string
+		count func(*Anytype) (int, error)
+	}{
+		{"spaces", "/v1/spaces", func(a *Anytype) (int, error) {
+			items, err := Collect(a.ListSpacesAll(context.Background(), ListSpacesInput{}, 0))
+			return len(items), err
+		}},
+		{"types", "/v1/spaces/space1/types", func(a *Anytype) (int, error) {
+			items, err := Collect(a.ListTypesAll(context.Background(), ListTypesInput{Params: ListTypesParams{SpaceId: "space1"}}, 0))
+			return len(items), err
+		}},
+		{"properties", "/v1/spaces/space1/properties", func(a *Anytype) (int, error) {
+			items, err := Collect(a.ListPropertiesAll(context.Background(), ListPropertiesInput{Params: ListPropertiesParams{SpaceId: "space1"}}, 0))
+			return len(items), err
+		}},
+		{"tags", "/v1/spaces/space1/properties/prop1/tags", func(a *Anytype) (int, error) {
+			items, err := Collect(a.ListTagsAll(context.Background(), ListTagsInput{Params: ListTagsParams{SpaceId: "space1", PropertyId: "prop1"}}, 0))
+			return len(items), err
+		}},
+		{"members", "/v1/spaces/space1/members", func(a *Anytype) (int, error) {
+			items, err := Collect(a.ListMembersAll(context.Background(), ListMembersInput{Params: ListMembersParams{SpaceId: "space1"}}, 0))
+			return len(items), err
+		}},
+		{"templates", "/v1/spaces/space1/types/type1/templates", func(a *Anytype) (int, error) {
+			items, err := Collect(a.ListTemplatesAll(context.Background(), ListTemplatesInput{Params: ListTemplatesParams{SpaceId: "space1", TypeId: "type1"}}, 0))
+			return len(items), err
+		}},
+		{"views", "/v1/spaces/space1/lists/list1/views", func(a *Anytype) (int, error) {
+			items, err := Collect(a.ListViewsAll(context.Background(), ListViewsInput{Params: ListViewsParams{SpaceId: "space1", ListId: "list1"}}, 0))
+			return len(items), err
+		}},
+		{"view objects", "/v1/spaces/space1/lists/list1/views/view1/objects", func(a *Anytype) (int, error) {
+			items, err := Collect(a.ListViewObjectsAll(context.Background(), ListViewObjectsInput{Params: ListViewObjectsParams{SpaceId: "space1", ListId: "list1", ViewId: "view1"}}, 0))
+			return len(items), err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, requests := newPagedServer(t, tt.path, 5, -1)
+			defer server.Close()
+
+			count, err := tt.count(New("test-api-key", WithApiServer(server.URL)))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if count != 5 || requests.Load() != 3 {
+				t.Errorf("expected 5 items in 3 requests, got %d items in %d requests", count, requests.Load())
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package anytype
+
+import "context"
+
+type ListViewsParams struct {
+	SpaceId string `json:"spaceId"`
+	ListId  string `json:"listId"`
+	Offset  int    `json:"offset"`
+	Limit   int    `json:"limit,omitempty"`
+}
+
+type ListViewsInput struct {
+	Params ListViewsParams `json:"params"`
+}
+
+type ListViewsOutput struct {
+	Data       []View     `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+func (a *Anytype) ListViews(ctx context.Context, input ListViewsInput) (*ListViewsOutput, error) {
+	var output ListViewsOutput
+
+	err := a.Get(ctx, "/v1/spaces/"+input.Params.SpaceId+"/lists/"+input.Params.ListId+"/views?"+paginate(input.Params.Offset, input.Params.Limit), &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+type ListViewObjectsParams struct {
+	SpaceId string `json:"spaceId"`
+	ListId  string `json:"listId"`
+	ViewId  string `json:"viewId"`
+	Offset  int    `json:"offset"`
+	Limit   int    `json:"limit,omitempty"`
+}
+
+type ListViewObjectsInput struct {
+	Params ListViewObjectsParams `json:"params"`
+}
+
+type ListViewObjectsOutput struct {
+	Data       []Object   `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+func (a *Anytype) ListViewObjects(ctx context.Context, input ListViewObjectsInput) (*ListViewObjectsOutput, error) {
+	var output ListViewObjectsOutput
+
+	path := "/v1/spaces/" + input.Params.SpaceId + "/lists/" + input.Params.ListId + "/views/" + input.Params.ViewId + "/objects"
+	err := a.Get(ctx, path+"?"+paginate(input.Params.Offset, input.Params.Limit), &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
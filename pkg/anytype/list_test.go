@@ -0,0 +1,109 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListViews(t *testing.T) {
+	expected := ListViewsOutput{
+		Data: []View{
+			{
+				ID:      "view1",
+				Name:    "Open Tasks",
+				Layout:  "grid",
+				Filters: []ViewFilter{{PropertyKey: "done", Condition: "eq"}},
+				Sorts:   []ViewSort{{PropertyKey: "due_date", SortType: "asc"}},
+			},
+		},
+		Pagination: Pagination{Total: 1, Offset: 0},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/spaces/space1/lists/list1/views" {
+			t.Errorf("expected path /v1/spaces/space1/lists/list1/views, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(expected)
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	result, err := client.ListViews(context.Background(), ListViewsInput{
+		Params: ListViewsParams{SpaceId: "space1", ListId: "list1"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(result, &expected) {
+		t.Errorf("expected %+v, got %+v", expected, *result)
+	}
+}
+
+func TestListViewObjects(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          ListViewObjectsInput
+		expectedParams string
+	}{
+		{
+			name: "first page",
+			input: ListViewObjectsInput{
+				Params: ListViewObjectsParams{SpaceId: "space1", ListId: "list1", ViewId: "view1"},
+			},
+			expectedParams: "offset=0",
+		},
+		{
+			name: "page with offset and limit",
+			input: ListViewObjectsInput{
+				Params: ListViewObjectsParams{SpaceId: "space1", ListId: "list1", ViewId: "view1", Offset: 20, Limit: 10},
+			},
+			expectedParams: "limit=10&offset=20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			expected := ListViewObjectsOutput{
+				Data:       []Object{{ID: "obj1", Name: "Write report", Type: ObjectType{Key: "task"}}},
+				Pagination: Pagination{Total: 21, Offset: tt.input.Params.Offset},
+			}
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/spaces/space1/lists/list1/views/view1/objects" {
+					t.Errorf("expected path /v1/spaces/space1/lists/list1/views/view1/objects, got %s", r.URL.Path)
+				}
+
+				if r.URL.RawQuery != tt.expectedParams {
+					t.Errorf("expected query params %s, got %s", tt.expectedParams, r.URL.RawQuery)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(expected)
+			}))
+			defer server.Close()
+
+			client := New("test-api-key", WithApiServer(server.URL))
+			result, err := client.ListViewObjects(context.Background(), tt.input)
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(result, &expected) {
+				t.Errorf("expected %+v, got %+v", expected, *result)
+			}
+		})
+	}
+}
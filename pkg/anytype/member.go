@@ -0,0 +1,63 @@
+package anytype
+
+import "context"
+
+// Member represents a participant of a shared space
+type Member struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	GlobalName string `json:"global_name,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+	Role       string `json:"role,omitempty"`
+	Status     string `json:"status,omitempty"`
+}
+
+type ListMembersParams struct {
+	SpaceId string `json:"spaceId"`
+	Offset  int    `json:"offset"`
+	Limit   int    `json:"limit,omitempty"`
+}
+
+type ListMembersInput struct {
+	Params ListMembersParams `json:"params"`
+}
+
+type ListMembersOutput struct {
+	Data       []Member   `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+func (a *Anytype) ListMembers(ctx context.Context, input ListMembersInput) (*ListMembersOutput, error) {
+	var output ListMembersOutput
+
+	err := a.Get(ctx, "/v1/spaces/"+input.Params.SpaceId+"/members?"+paginate(input.Params.Offset, input.Params.Limit), &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+type GetMemberParams struct {
+	SpaceId  string `json:"spaceId"`
+	MemberId string `json:"memberId"`
+}
+
+type GetMemberInput struct {
+	Params GetMemberParams `json:"params"`
+}
+
+type GetMemberOutput struct {
+	Member Member `json:"member"`
+}
+
+func (a *Anytype) GetMember(ctx context.Context, input GetMemberInput) (*GetMemberOutput, error) {
+	var output GetMemberOutput
+
+	err := a.Get(ctx, "/v1/spaces/"+input.Params.SpaceId+"/members/"+input.Params.MemberId, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,82 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListMembers(t *testing.T) {
+	expected := ListMembersOutput{
+		Data: []Member{
+			{ID: "_participant_space1_alice", Name: "Alice", GlobalName: "alice.any", Role: "owner", Status: "active"},
+			{ID: "_participant_space1_bob", Name: "Bob", Role: "editor", Status: "active"},
+		},
+		Pagination: Pagination{Total: 2, Offset: 0},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/v1/spaces/space1/members" {
+			t.Errorf("expected path /v1/spaces/space1/members, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(expected)
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	result, err := client.ListMembers(context.Background(), ListMembersInput{
+		Params: ListMembersParams{SpaceId: "space1"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(result, &expected) {
+		t.Errorf("expected %+v, got %+v", expected, *result)
+	}
+}
+
+func TestGetMember(t *testing.T) {
+	expected := GetMemberOutput{
+		Member: Member{ID: "_participant_space1_alice", Name: "Alice", GlobalName: "alice.any", Role: "owner", Status: "active"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/v1/spaces/space1/members/_participant_space1_alice" {
+			t.Errorf("expected path /v1/spaces/space1/members/_participant_space1_alice, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(expected)
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	result, err := client.GetMember(context.Background(), GetMemberInput{
+		Params: GetMemberParams{SpaceId: "space1", MemberId: "_participant_space1_alice"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(result, &expected) {
+		t.Errorf("expected %+v, got %+v", expected, *result)
+	}
+}
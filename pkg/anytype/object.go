@@ -25,3 +25,91 @@ func (a *Anytype) GetObject(ctx context.Context, input GetObjectInput) (*GetObje
 
 	return &output, nil
 }
+
+type CreateObjectParams struct {
+	SpaceId string `json:"spaceId"`
+}
+
+type CreateObjectBody struct {
+	Name       string          `json:"name"`
+	TypeKey    string          `json:"type_key"`
+	TemplateId string          `json:"template_id,omitempty"`
+	Body       string          `json:"body,omitempty"`
+	Properties []PropertyValue `json:"properties,omitempty"`
+}
+
+type CreateObjectInput struct {
+	Params CreateObjectParams `json:"params"`
+	Body   CreateObjectBody   `json:"body"`
+}
+
+type CreateObjectOutput struct {
+	Object Object `json:"object"`
+}
+
+func (a *Anytype) CreateObject(ctx context.Context, input CreateObjectInput) (*CreateObjectOutput, error) {
+	var output CreateObjectOutput
+
+	err := a.Post(ctx, "/v1/spaces/"+input.Params.SpaceId+"/objects", input.Body, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+type UpdateObjectParams struct {
+	ObjectId string `json:"objectId"`
+	SpaceId  string `json:"spaceId"`
+}
+
+type UpdateObjectBody struct {
+	Name       string          `json:"name,omitempty"`
+	Body       string          `json:"body,omitempty"`
+	Properties []PropertyValue `json:"properties,omitempty"`
+}
+
+type UpdateObjectInput struct {
+	Params UpdateObjectParams `json:"params"`
+	Body   UpdateObjectBody   `json:"body"`
+}
+
+type UpdateObjectOutput struct {
+	Object Object `json:"object"`
+}
+
+func (a *Anytype) UpdateObject(ctx context.Context, input UpdateObjectInput) (*UpdateObjectOutput, error) {
+	var output UpdateObjectOutput
+
+	err := a.Patch(ctx, "/v1/spaces/"+input.Params.SpaceId+"/objects/"+input.Params.ObjectId, input.Body, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+type DeleteObjectParams struct {
+	ObjectId string `json:"objectId"`
+	SpaceId  string `json:"spaceId"`
+}
+
+type DeleteObjectInput struct {
+	Params DeleteObjectParams `json:"params"`
+}
+
+type DeleteObjectOutput struct {
+	Object Object `json:"object"`
+}
+
+// DeleteObject archives the object, it can be restored from the bin in Anytype.
+func (a *Anytype) DeleteObject(ctx context.Context, input DeleteObjectInput) (*DeleteObjectOutput, error) {
+	var output DeleteObjectOutput
+
+	err := a.Delete(ctx, "/v1/spaces/"+input.Params.SpaceId+"/objects/"+input.Params.ObjectId, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
@@ -3,10 +3,12 @@ package anytype
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestGetObject_Success(t *testing.T) {
@@ -77,6 +79,39 @@ func TestGetObject_Success(t *testing.T) {
 			},
 			expectedPath: "/v1/spaces/space101/objects/obj789",
 		},
+		{
+			name: "object with every property format",
+			input: GetObjectInput{
+				Params: GetObjectParams{
+					ObjectId: "obj555",
+					SpaceId:  "space555",
+				},
+			},
+			mockResponse: GetObjectOutput{
+				Object: Object{
+					ID:      "obj555",
+					SpaceId: "space555",
+					Name:    "Formats Object",
+					Type: ObjectType{
+						ID:   "type4",
+						Key:  "task",
+						Name: "Task",
+					},
+					Properties: []Property{
+						{ID: "p1", Key: "estimate", Name: "Estimate", Format: "number", Number: new(float64)},
+						{ID: "p2", Key: "done", Name: "Done", Format: "checkbox", Checkbox: true},
+						{ID: "p3", Key: "source", Name: "Source", Format: "url", Url: "https://anytype.io"},
+						{ID: "p4", Key: "contact", Name: "Contact", Format: "email", Email: "user@example.com"},
+						{ID: "p5", Key: "mobile", Name: "Mobile", Format: "phone", Phone: "+1 555 0100"},
+						{ID: "p6", Key: "status", Name: "Status", Format: "select", Select: &Tag{ID: "tag1", Key: "done", Name: "Done", Color: "lime"}},
+						{ID: "p7", Key: "tag", Name: "Tag", Format: "multi_select", MultiSelect: []Tag{{ID: "tag2", Name: "work"}, {ID: "tag3", Name: "urgent"}}},
+						{ID: "p8", Key: "attachments", Name: "Attachments", Format: "files", Files: []string{"file1"}},
+						{ID: "p9", Key: "links", Name: "Links", Format: "objects", Objects: []string{"obj1", "obj2"}},
+					},
+				},
+			},
+			expectedPath: "/v1/spaces/space555/objects/obj555",
+		},
 		{
 			name: "object with special characters in IDs",
 			input: GetObjectInput{
@@ -395,3 +430,166 @@ func TestGetObject_ContextCancellation(t *testing.T) {
 		t.Fatalf("expected network/context error, got API error: %v", err)
 	}
 }
+
+func TestCreateObject(t *testing.T) {
+	status := "tag1"
+	expected := CreateObjectOutput{
+		Object: Object{ID: "obj1", SpaceId: "space1", Name: "Weekly Sync"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/v1/spaces/space1/objects" {
+			t.Errorf("expected path /v1/spaces/space1/objects, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		expectedBody := `{"name":"Weekly Sync","type_key":"page","body":"# Notes","properties":[{"key":"status","select":"tag1"}]}`
+		if string(body) != expectedBody {
+			t.Errorf("expected body %s, got %s", expectedBody, body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(expected)
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	result, err := client.CreateObject(context.Background(), CreateObjectInput{
+		Params: CreateObjectParams{SpaceId: "space1"},
+		Body: CreateObjectBody{
+			Name:       "Weekly Sync",
+			TypeKey:    "page",
+			Body:       "# Notes",
+			Properties: []PropertyValue{{Key: "status", Select: &status}},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(result, &expected) {
+		t.Errorf("expected %+v, got %+v", expected, *result)
+	}
+}
+
+func TestUpdateObject(t *testing.T) {
+	done := true
+	expected := UpdateObjectOutput{
+		Object: Object{ID: "obj1", SpaceId: "space1", Name: "Renamed"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/v1/spaces/space1/objects/obj1" {
+			t.Errorf("expected path /v1/spaces/space1/objects/obj1, got %s", r.URL.Path)
+		}
+
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("expected Content-Type header 'application/json', got %s", r.Header.Get("Content-Type"))
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		expectedBody := `{"name":"Renamed","body":"Updated","properties":[{"key":"done","checkbox":true},{"key":"tag","multi_select":[]}]}`
+		if string(body) != expectedBody {
+			t.Errorf("expected body %s, got %s", expectedBody, body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(expected)
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	result, err := client.UpdateObject(context.Background(), UpdateObjectInput{
+		Params: UpdateObjectParams{ObjectId: "obj1", SpaceId: "space1"},
+		Body: UpdateObjectBody{
+			Name:       "Renamed",
+			Body:       "Updated",
+			Properties: []PropertyValue{{Key: "done", Checkbox: &done}, {Key: "tag", MultiSelect: &[]string{}}},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(result, &expected) {
+		t.Errorf("expected %+v, got %+v", expected, *result)
+	}
+}
+
+func TestDeleteObject(t *testing.T) {
+	expected := DeleteObjectOutput{
+		Object: Object{ID: "obj1", SpaceId: "space1", Name: "Old Note"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/v1/spaces/space1/objects/obj1" {
+			t.Errorf("expected path /v1/spaces/space1/objects/obj1, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(expected)
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	result, err := client.DeleteObject(context.Background(), DeleteObjectInput{
+		Params: DeleteObjectParams{ObjectId: "obj1", SpaceId: "space1"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(result, &expected) {
+		t.Errorf("expected %+v, got %+v", expected, *result)
+	}
+}
+
+func TestObject_LastModified(t *testing.T) {
+	tests := []struct {
+		name     string
+		object   Object
+		expected time.Time
+	}{
+		{
+			name:     "last modified date",
+			object:   Object{Properties: []Property{{Key: "last_modified_date", Format: "date", Date: "2024-05-01T10:00:00Z"}}},
+			expected: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "missing property",
+			object: Object{Properties: []Property{{Key: "created_date", Format: "date", Date: "2024-05-01T10:00:00Z"}}},
+		},
+		{
+			name:   "invalid date",
+			object: Object{Properties: []Property{{Key: "last_modified_date", Format: "date", Date: "yesterday"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if modified := tt.object.LastModified(); !modified.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, modified)
+			}
+		})
+	}
+}
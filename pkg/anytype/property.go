@@ -0,0 +1,53 @@
+package anytype
+
+import "context"
+
+type ListPropertiesParams struct {
+	SpaceId string `json:"spaceId"`
+	Offset  int    `json:"offset"`
+	Limit   int    `json:"limit,omitempty"`
+}
+
+type ListPropertiesInput struct {
+	Params ListPropertiesParams `json:"params"`
+}
+
+type ListPropertiesOutput struct {
+	Data       []PropertyDefinition `json:"data"`
+	Pagination Pagination           `json:"pagination"`
+}
+
+func (a *Anytype) ListProperties(ctx context.Context, input ListPropertiesInput) (*ListPropertiesOutput, error) {
+	var output ListPropertiesOutput
+
+	err := a.Get(ctx, "/v1/spaces/"+input.Params.SpaceId+"/properties?"+paginate(input.Params.Offset, input.Params.Limit), &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+type GetPropertyParams struct {
+	SpaceId    string `json:"spaceId"`
+	PropertyId string `json:"propertyId"`
+}
+
+type GetPropertyInput struct {
+	Params GetPropertyParams `json:"params"`
+}
+
+type GetPropertyOutput struct {
+	Property PropertyDefinition `json:"property"`
+}
+
+func (a *Anytype) GetProperty(ctx context.Context, input GetPropertyInput) (*GetPropertyOutput, error) {
+	var output GetPropertyOutput
+
+	err := a.Get(ctx, "/v1/spaces/"+input.Params.SpaceId+"/properties/"+input.Params.PropertyId, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,107 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListProperties_Success(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          ListPropertiesInput
+		mockResponse   ListPropertiesOutput
+		expectedParams string
+	}{
+		{
+			name: "first page",
+			input: ListPropertiesInput{
+				Params: ListPropertiesParams{SpaceId: "space1"},
+			},
+			mockResponse: ListPropertiesOutput{
+				Data: []PropertyDefinition{
+					{ID: "prop1", Key: "status", Name: "Status", Format: "select"},
+					{ID: "prop2", Key: "due_date", Name: "Due Date", Format: "date"},
+				},
+				Pagination: Pagination{Total: 3, Offset: 0, Limit: 2, HasMore: true},
+			},
+			expectedParams: "offset=0",
+		},
+		{
+			name: "second page",
+			input: ListPropertiesInput{
+				Params: ListPropertiesParams{SpaceId: "space1", Offset: 2, Limit: 2},
+			},
+			mockResponse: ListPropertiesOutput{
+				Data:       []PropertyDefinition{{ID: "prop3", Key: "estimate", Name: "Estimate", Format: "number"}},
+				Pagination: Pagination{Total: 3, Offset: 2, Limit: 2},
+			},
+			expectedParams: "limit=2&offset=2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/spaces/space1/properties" {
+					t.Errorf("expected path /v1/spaces/space1/properties, got %s", r.URL.Path)
+				}
+
+				if r.URL.RawQuery != tt.expectedParams {
+					t.Errorf("expected query params %s, got %s", tt.expectedParams, r.URL.RawQuery)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(tt.mockResponse)
+			}))
+			defer server.Close()
+
+			client := New("test-api-key", WithApiServer(server.URL))
+			result, err := client.ListProperties(context.Background(), tt.input)
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(result, &tt.mockResponse) {
+				t.Errorf("expected %+v, got %+v", tt.mockResponse, *result)
+			}
+		})
+	}
+}
+
+func TestGetProperty_Success(t *testing.T) {
+	expected := GetPropertyOutput{
+		Property: PropertyDefinition{ID: "prop1", Key: "status", Name: "Status", Format: "select"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/spaces/space1/properties/prop1" {
+			t.Errorf("expected path /v1/spaces/space1/properties/prop1, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(expected)
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	result, err := client.GetProperty(context.Background(), GetPropertyInput{
+		Params: GetPropertyParams{SpaceId: "space1", PropertyId: "prop1"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(result, &expected) {
+		t.Errorf("expected %+v, got %+v", expected, *result)
+	}
+}
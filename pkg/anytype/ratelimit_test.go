@@ -0,0 +1,48 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(GetSpaceOutput{})
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL), WithRateLimit(50, 2))
+
+	start := time.Now()
+	for range 5 {
+		_, err := client.GetSpace(context.Background(), GetSpaceInput{Params: GetSpaceParams{SpaceId: "space1"}})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	// the first 2 requests use the burst, the remaining 3 requests wait 20ms each
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected requests to be rate limited, took %v", elapsed)
+	}
+}
+
+func TestRateLimiter_ContextCanceled(t *testing.T) {
+	limiter := newRateLimiter(1, 1)
+
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("expected burst token available, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Wait(ctx); err == nil {
+		t.Fatal("expected error when context is done before token is available")
+	}
+}
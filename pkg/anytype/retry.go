@@ -0,0 +1,120 @@
+package anytype
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried, zero MaxRetries disables retry.
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// DefaultRetryPolicy retries up to 3 times with backoff from 200ms up to 5s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  200 * time.Millisecond,
+		MaxDelay:   5 * time.Second,
+	}
+}
+
+// WithRetry retries requests failed by rate limit, server or network errors.
+// POST and PATCH requests are only retried when the request is known to not be processed.
+func WithRetry(policy RetryPolicy) AnytypeOption {
+	return func(a *Anytype) {
+		a.retry = policy
+	}
+}
+
+type idempotentKey struct{}
+
+// withIdempotent marks a POST request as safe to retry, e.g. search.
+func withIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentKey{}, true)
+}
+
+func isIdempotent(ctx context.Context, method string) bool {
+	if method != http.MethodPost && method != http.MethodPatch {
+		return true
+	}
+
+	idempotent, _ := ctx.Value(idempotentKey{}).(bool)
+	return idempotent
+}
+
+// retryable reports whether the failed attempt can be retried, status is zero when no response is received.
+func retryable(ctx context.Context, status int, err error, idempotent bool) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	switch {
+	case status == http.StatusTooManyRequests:
+		return true
+	case status >= 500 && status != http.StatusNotImplemented:
+		return idempotent
+	case status == 0 && err != nil:
+		return idempotent || errors.Is(err, syscall.ECONNREFUSED)
+	}
+
+	return false
+}
+
+// backoff returns the delay before the next attempt with jitter, the Retry-After is preferred when given.
+func (p RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	delay := p.BaseDelay << attempt
+	if delay <= 0 || (p.MaxDelay > 0 && delay > p.MaxDelay) {
+		delay = p.MaxDelay
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// parseRetryAfter supports both delay seconds and HTTP date of the Retry-After header.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return 0
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
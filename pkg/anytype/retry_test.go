@@ -0,0 +1,295 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func newFlakyServer(failures int, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if int(calls.Add(1)) <= failures {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(status)
+			json.NewEncoder(w).Encode(Error{Code: "error", Message: http.StatusText(status), Status: status})
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]any{"space": Space{ID: "space1"}, "object": Object{ID: "obj1"}})
+	}))
+
+	return server, &calls
+}
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+}
+
+func TestWithRetry(t *testing.T) {
+	tests := []struct {
+		name          string
+		failures      int
+		status        int
+		header        http.Header
+		call          func(context.Context, *Anytype) error
+		expectedCalls int32
+		expectError   bool
+	}{
+		{
+			name:     "get recovers from server errors",
+			failures: 2,
+			status:   http.StatusServiceUnavailable,
+			call: func(ctx context.Context, a *Anytype) error {
+				_, err := a.GetSpace(ctx, GetSpaceInput{Params: GetSpaceParams{SpaceId: "space1"}})
+				return err
+			},
+			expectedCalls: 3,
+		},
+		{
+			name:     "get gives up after max retries",
+			failures: 10,
+			status:   http.StatusBadGateway,
+			call: func(ctx context.Context, a *Anytype) error {
+				_, err := a.GetSpace(ctx, GetSpaceInput{Params: GetSpaceParams{SpaceId: "space1"}})
+				return err
+			},
+			expectedCalls: 4,
+			expectError:   true,
+		},
+		{
+			name:     "client errors are not retried",
+			failures: 1,
+			status:   http.StatusNotFound,
+			call: func(ctx context.Context, a *Anytype) error {
+				_, err := a.GetSpace(ctx, GetSpaceInput{Params: GetSpaceParams{SpaceId: "space1"}})
+				return err
+			},
+			expectedCalls: 1,
+			expectError:   true,
+		},
+		{
+			name:     "post is not retried on server errors",
+			failures: 1,
+			status:   http.StatusInternalServerError,
+			call: func(ctx context.Context, a *Anytype) error {
+				_, err := a.CreateObject(ctx, CreateObjectInput{Params: CreateObjectParams{SpaceId: "space1"}})
+				return err
+			},
+			expectedCalls: 1,
+			expectError:   true,
+		},
+		{
+			name:     "post is retried on rate limit",
+			failures: 2,
+			status:   http.StatusTooManyRequests,
+			call: func(ctx context.Context, a *Anytype) error {
+				_, err := a.CreateObject(ctx, CreateObjectInput{Params: CreateObjectParams{SpaceId: "space1"}})
+				return err
+			},
+			expectedCalls: 3,
+		},
+		{
+			name:     "search is retried on server errors",
+			failures: 1,
+			status:   http.StatusServiceUnavailable,
+			call: func(ctx context.Context, a *Anytype) error {
+				_, err := a.Search(ctx, SearchInput{Body: SearchBody{Query: "test"}})
+				return err
+			},
+			expectedCalls: 2,
+		},
+		{
+			name:     "retry after within max delay",
+			failures: 1,
+			status:   http.StatusTooManyRequests,
+			header:   http.Header{"Retry-After": []string{"0"}},
+			call: func(ctx context.Context, a *Anytype) error {
+				_, err := a.GetSpace(ctx, GetSpaceInput{Params: GetSpaceParams{SpaceId: "space1"}})
+				return err
+			},
+			expectedCalls: 2,
+		},
+		{
+			name:     "retry after longer than max delay gives up",
+			failures: 1,
+			status:   http.StatusTooManyRequests,
+			header:   http.Header{"Retry-After": []string{"60"}},
+			call: func(ctx context.Context, a *Anytype) error {
+				_, err := a.GetSpace(ctx, GetSpaceInput{Params: GetSpaceParams{SpaceId: "space1"}})
+				return err
+			},
+			expectedCalls: 1,
+			expectError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, calls := newFlakyServer(tt.failures, tt.status, tt.header)
+			defer server.Close()
+
+			client := New("test-api-key", WithApiServer(server.URL), WithRetry(testRetryPolicy()))
+			err := tt.call(context.Background(), client)
+
+			if tt.expectError && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+
+			if !tt.expectError && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if calls.Load() != tt.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tt.expectedCalls, calls.Load())
+			}
+		})
+	}
+}
+
+func TestWithRetry_Disabled(t *testing.T) {
+	server, calls := newFlakyServer(1, http.StatusServiceUnavailable, nil)
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	_, err := client.GetSpace(context.Background(), GetSpaceInput{Params: GetSpaceParams{SpaceId: "space1"}})
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestWithRetry_ContextCanceled(t *testing.T) {
+	server, calls := newFlakyServer(10, http.StatusServiceUnavailable, nil)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	policy := RetryPolicy{MaxRetries: 100, BaseDelay: time.Second, MaxDelay: time.Second}
+	client := New("test-api-key", WithApiServer(server.URL), WithRetry(policy))
+
+	start := time.Now()
+	_, err := client.GetSpace(ctx, GetSpaceInput{Params: GetSpaceParams{SpaceId: "space1"}})
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected to stop retry when context is done, took %v", elapsed)
+	}
+
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	refused := &url.Error{Op: "Post", URL: "http://127.0.0.1:31009", Err: &os.SyscallError{Syscall: "connect", Err: syscall.ECONNREFUSED}}
+	reset := &url.Error{Op: "Post", URL: "http://127.0.0.1:31009", Err: &os.SyscallError{Syscall: "read", Err: syscall.ECONNRESET}}
+
+	tests := []struct {
+		name       string
+		status     int
+		err        error
+		idempotent bool
+		expected   bool
+	}{
+		{"rate limited", http.StatusTooManyRequests, errors.New("error"), false, true},
+		{"server error of idempotent request", http.StatusServiceUnavailable, errors.New("error"), true, true},
+		{"server error of non-idempotent request", http.StatusServiceUnavailable, errors.New("error"), false, false},
+		{"not implemented", http.StatusNotImplemented, errors.New("error"), true, false},
+		{"bad request", http.StatusBadRequest, errors.New("error"), true, false},
+		{"connection refused of non-idempotent request", 0, refused, false, true},
+		{"connection reset of non-idempotent request", 0, reset, false, false},
+		{"connection reset of idempotent request", 0, reset, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := retryable(context.Background(), tt.status, tt.err, tt.idempotent)
+			if actual != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	tests := []struct {
+		attempt    int
+		retryAfter time.Duration
+		min        time.Duration
+		max        time.Duration
+	}{
+		{0, 0, 50 * time.Millisecond, 100 * time.Millisecond},
+		{1, 0, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 0, 400 * time.Millisecond, 800 * time.Millisecond},
+		{10, 0, 500 * time.Millisecond, time.Second},
+		{0, 300 * time.Millisecond, 300 * time.Millisecond, 300 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("attempt %d retry after %v", tt.attempt, tt.retryAfter), func(t *testing.T) {
+			t.Parallel()
+
+			for range 100 {
+				delay := policy.backoff(tt.attempt, tt.retryAfter)
+				if delay < tt.min || delay > tt.max {
+					t.Fatalf("expected delay between %v and %v, got %v", tt.min, tt.max, delay)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+	}{
+		{"empty", "", 0},
+		{"seconds", "3", 3 * time.Second},
+		{"http date", now.Add(5 * time.Second).Format(http.TimeFormat), 5 * time.Second},
+		{"past http date", now.Add(-5 * time.Second).Format(http.TimeFormat), 0},
+		{"invalid", "soon", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := parseRetryAfter(tt.value, now)
+			if actual != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -1,26 +1,81 @@
 package anytype
 
+import "time"
+
 // ObjectType represents the type of an Anytype object
 type ObjectType struct {
-	ID   string `json:"id"`
-	Key  string `json:"key"`
-	Name string `json:"name"`
+	ID         string               `json:"id"`
+	Key        string               `json:"key"`
+	Name       string               `json:"name"`
+	Layout     string               `json:"layout,omitempty"`
+	Properties []PropertyDefinition `json:"properties,omitempty"`
 }
 
-// Property represents a property of an Anytype object
-type Property struct {
+// PropertyDefinition represents a property defined in a space
+type PropertyDefinition struct {
 	ID     string `json:"id"`
 	Key    string `json:"key"`
 	Name   string `json:"name"`
 	Format string `json:"format"`
-	Date   string `json:"date,omitempty"`
-	Text   string `json:"text,omitempty"`
+}
+
+// Tag represents an option of a select or multi_select property
+type Tag struct {
+	ID    string `json:"id"`
+	Key   string `json:"key,omitempty"`
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
+}
+
+// Property represents a property of an Anytype object
+type Property struct {
+	ID          string   `json:"id"`
+	Key         string   `json:"key"`
+	Name        string   `json:"name"`
+	Format      string   `json:"format"`
+	Date        string   `json:"date,omitempty"`
+	Text        string   `json:"text,omitempty"`
+	Number      *float64 `json:"number,omitempty"`
+	Checkbox    bool     `json:"checkbox,omitempty"`
+	Url         string   `json:"url,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	Phone       string   `json:"phone,omitempty"`
+	Select      *Tag     `json:"select,omitempty"`
+	MultiSelect []Tag    `json:"multi_select,omitempty"`
+	Files       []string `json:"files,omitempty"`
+	Objects     []string `json:"objects,omitempty"`
+}
+
+// PropertyValue represents a property value to set on an Anytype object,
+// the lists are pointers so an empty list can be sent to clear the value.
+type PropertyValue struct {
+	Key         string    `json:"key"`
+	Text        *string   `json:"text,omitempty"`
+	Number      *float64  `json:"number,omitempty"`
+	Select      *string   `json:"select,omitempty"`
+	MultiSelect *[]string `json:"multi_select,omitempty"`
+	Date        *string   `json:"date,omitempty"`
+	Files       *[]string `json:"files,omitempty"`
+	Checkbox    *bool     `json:"checkbox,omitempty"`
+	Url         *string   `json:"url,omitempty"`
+	Email       *string   `json:"email,omitempty"`
+	Phone       *string   `json:"phone,omitempty"`
+	Objects     *[]string `json:"objects,omitempty"`
 }
 
 // Pagination represents pagination information for search results
 type Pagination struct {
-	Total  int `json:"total"`
-	Offset int `json:"offset"`
+	Total   int  `json:"total"`
+	Offset  int  `json:"offset"`
+	Limit   int  `json:"limit,omitempty"`
+	HasMore bool `json:"has_more,omitempty"`
+}
+
+// Space represents an Anytype space
+type Space struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
 }
 
 // Object represents an Anytype object
@@ -32,3 +87,49 @@ type Object struct {
 	Type       ObjectType `json:"type"`
 	Properties []Property `json:"properties,omitempty"`
 }
+
+// LastModified reads the last_modified_date property, it is zero when the property is missing or invalid.
+func (o *Object) LastModified() time.Time {
+	for _, prop := range o.Properties {
+		if prop.Key != "last_modified_date" {
+			continue
+		}
+
+		modified, err := time.Parse(time.RFC3339, prop.Date)
+		if err != nil {
+			return time.Time{}
+		}
+
+		return modified
+	}
+
+	return time.Time{}
+}
+
+// View represents a saved view of a set or collection
+type View struct {
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	Layout     string         `json:"layout,omitempty"`
+	Filters    []ViewFilter   `json:"filters,omitempty"`
+	Sorts      []ViewSort     `json:"sorts,omitempty"`
+	Properties []ViewProperty `json:"properties,omitempty"`
+}
+
+// ViewProperty represents a property column of a view
+type ViewProperty struct {
+	PropertyKey string `json:"property_key"`
+	Visible     bool   `json:"visible"`
+}
+
+// ViewFilter represents a filter condition of a view
+type ViewFilter struct {
+	PropertyKey string `json:"property_key"`
+	Condition   string `json:"condition,omitempty"`
+}
+
+// ViewSort represents a sort order of a view
+type ViewSort struct {
+	PropertyKey string `json:"property_key"`
+	SortType    string `json:"sort_type,omitempty"`
+}
@@ -1,17 +1,22 @@
 package anytype
 
-import (
-	"context"
-	"net/url"
-	"strconv"
-)
+import "context"
 
 type SearchParams struct {
-	Offset int `json:"offset"`
+	SpaceId string `json:"spaceId,omitempty"`
+	Offset  int    `json:"offset"`
+	Limit   int    `json:"limit,omitempty"`
+}
+
+type SearchSort struct {
+	PropertyKey string `json:"property_key"`
+	Direction   string `json:"direction,omitempty"`
 }
 
 type SearchBody struct {
-	Query string `json:"query"`
+	Query string      `json:"query"`
+	Types []string    `json:"types,omitempty"`
+	Sort  *SearchSort `json:"sort,omitempty"`
 }
 
 type SearchInput struct {
@@ -27,10 +32,13 @@ type SearchOutput struct {
 func (a *Anytype) Search(ctx context.Context, input SearchInput) (*SearchOutput, error) {
 	var output SearchOutput
 
-	params := url.Values{}
-	params.Add("offset", strconv.Itoa(input.Params.Offset))
+	path := "/v1/search"
+	if input.Params.SpaceId != "" {
+		path = "/v1/spaces/" + input.Params.SpaceId + "/search"
+	}
 
-	err := a.Post(ctx, "/v1/search?"+params.Encode(), input.Body, &output)
+	// search does not change any data which is safe to retry
+	err := a.Post(withIdempotent(ctx), path+"?"+paginate(input.Params.Offset, input.Params.Limit), input.Body, &output)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package anytype
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -262,6 +263,77 @@ func TestSearch_ErrorHandling(t *testing.T) {
 	}
 }
 
+func TestSearch_SpaceScoped(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        SearchInput
+		expectedPath string
+		expectedRaw  string
+		expectedBody string
+	}{
+		{
+			name: "global search keeps minimal body",
+			input: SearchInput{
+				Body: SearchBody{Query: "test"},
+			},
+			expectedPath: "/v1/search",
+			expectedRaw:  "offset=0",
+			expectedBody: `{"query":"test"}`,
+		},
+		{
+			name: "space search with filters",
+			input: SearchInput{
+				Params: SearchParams{SpaceId: "space1", Offset: 20, Limit: 5},
+				Body: SearchBody{
+					Query: "test",
+					Types: []string{"page", "task"},
+					Sort:  &SearchSort{PropertyKey: "last_modified_date", Direction: "desc"},
+				},
+			},
+			expectedPath: "/v1/spaces/space1/search",
+			expectedRaw:  "limit=5&offset=20",
+			expectedBody: `{"query":"test","types":["page","task"],"sort":{"property_key":"last_modified_date","direction":"desc"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.expectedPath {
+					t.Errorf("expected path %s, got %s", tt.expectedPath, r.URL.Path)
+				}
+
+				if r.URL.RawQuery != tt.expectedRaw {
+					t.Errorf("expected query params %s, got %s", tt.expectedRaw, r.URL.RawQuery)
+				}
+
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatalf("failed to read request body: %v", err)
+				}
+
+				if string(body) != tt.expectedBody {
+					t.Errorf("expected body %s, got %s", tt.expectedBody, body)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(SearchOutput{})
+			}))
+			defer server.Close()
+
+			client := New("test-api-key", WithApiServer(server.URL))
+			_, err := client.Search(context.Background(), tt.input)
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
 func TestSearch_NetworkError(t *testing.T) {
 	client := New("test-api-key", WithApiServer("http://localhost:99999"))
 
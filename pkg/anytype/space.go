@@ -0,0 +1,51 @@
+package anytype
+
+import "context"
+
+type ListSpacesParams struct {
+	Offset int `json:"offset"`
+	Limit  int `json:"limit,omitempty"`
+}
+
+type ListSpacesInput struct {
+	Params ListSpacesParams `json:"params"`
+}
+
+type ListSpacesOutput struct {
+	Data       []Space    `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+func (a *Anytype) ListSpaces(ctx context.Context, input ListSpacesInput) (*ListSpacesOutput, error) {
+	var output ListSpacesOutput
+
+	err := a.Get(ctx, "/v1/spaces?"+paginate(input.Params.Offset, input.Params.Limit), &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+type GetSpaceParams struct {
+	SpaceId string `json:"spaceId"`
+}
+
+type GetSpaceInput struct {
+	Params GetSpaceParams `json:"params"`
+}
+
+type GetSpaceOutput struct {
+	Space Space `json:"space"`
+}
+
+func (a *Anytype) GetSpace(ctx context.Context, input GetSpaceInput) (*GetSpaceOutput, error) {
+	var output GetSpaceOutput
+
+	err := a.Get(ctx, "/v1/spaces/"+input.Params.SpaceId, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,136 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListSpaces_Success(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          ListSpacesInput
+		mockResponse   ListSpacesOutput
+		expectedParams string
+	}{
+		{
+			name:  "first page",
+			input: ListSpacesInput{},
+			mockResponse: ListSpacesOutput{
+				Data: []Space{
+					{ID: "space1", Name: "Work", Description: "Work notes"},
+					{ID: "space2", Name: "Personal"},
+				},
+				Pagination: Pagination{Total: 2, Offset: 0, Limit: 100},
+			},
+			expectedParams: "offset=0",
+		},
+		{
+			name: "page with offset and limit",
+			input: ListSpacesInput{
+				Params: ListSpacesParams{Offset: 10, Limit: 5},
+			},
+			mockResponse: ListSpacesOutput{
+				Data:       []Space{{ID: "space11", Name: "Archive"}},
+				Pagination: Pagination{Total: 11, Offset: 10, Limit: 5},
+			},
+			expectedParams: "limit=5&offset=10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Errorf("expected GET method, got %s", r.Method)
+				}
+
+				if r.URL.Path != "/v1/spaces" {
+					t.Errorf("expected path /v1/spaces, got %s", r.URL.Path)
+				}
+
+				if r.URL.RawQuery != tt.expectedParams {
+					t.Errorf("expected query params %s, got %s", tt.expectedParams, r.URL.RawQuery)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(tt.mockResponse)
+			}))
+			defer server.Close()
+
+			client := New("test-api-key", WithApiServer(server.URL))
+			result, err := client.ListSpaces(context.Background(), tt.input)
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(result, &tt.mockResponse) {
+				t.Errorf("expected %+v, got %+v", tt.mockResponse, *result)
+			}
+		})
+	}
+}
+
+func TestGetSpace_Success(t *testing.T) {
+	expected := GetSpaceOutput{
+		Space: Space{ID: "space1", Name: "Work", Description: "Work notes"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/spaces/space1" {
+			t.Errorf("expected path /v1/spaces/space1, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(expected)
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	result, err := client.GetSpace(context.Background(), GetSpaceInput{
+		Params: GetSpaceParams{SpaceId: "space1"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(result, &expected) {
+		t.Errorf("expected %+v, got %+v", expected, *result)
+	}
+}
+
+func TestGetSpace_ErrorHandling(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(Error{Code: "not_found", Message: "Space not found", Object: "space", Status: 404})
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	_, err := client.GetSpace(context.Background(), GetSpaceInput{
+		Params: GetSpaceParams{SpaceId: "missing"},
+	})
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	apiErr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected *Error type, got %T", err)
+	}
+
+	if apiErr.Status != 404 {
+		t.Errorf("expected error status 404, got %d", apiErr.Status)
+	}
+}
@@ -0,0 +1,125 @@
+package anytype
+
+import "context"
+
+type ListTagsParams struct {
+	SpaceId    string `json:"spaceId"`
+	PropertyId string `json:"propertyId"`
+	Offset     int    `json:"offset"`
+	Limit      int    `json:"limit,omitempty"`
+}
+
+type ListTagsInput struct {
+	Params ListTagsParams `json:"params"`
+}
+
+type ListTagsOutput struct {
+	Data       []Tag      `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+func (a *Anytype) ListTags(ctx context.Context, input ListTagsInput) (*ListTagsOutput, error) {
+	var output ListTagsOutput
+
+	err := a.Get(ctx, "/v1/spaces/"+input.Params.SpaceId+"/properties/"+input.Params.PropertyId+"/tags?"+paginate(input.Params.Offset, input.Params.Limit), &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// TagColors are the colors accepted by Anytype for tags.
+var TagColors = []string{"grey", "yellow", "orange", "red", "pink", "purple", "blue", "ice", "teal", "lime"}
+
+type TagParams struct {
+	SpaceId    string `json:"spaceId"`
+	PropertyId string `json:"propertyId"`
+	TagId      string `json:"tagId"`
+}
+
+type TagOutput struct {
+	Tag Tag `json:"tag"`
+}
+
+type GetTagInput struct {
+	Params TagParams `json:"params"`
+}
+
+func (a *Anytype) GetTag(ctx context.Context, input GetTagInput) (*TagOutput, error) {
+	var output TagOutput
+
+	err := a.Get(ctx, tagPath(input.Params), &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+type CreateTagParams struct {
+	SpaceId    string `json:"spaceId"`
+	PropertyId string `json:"propertyId"`
+}
+
+type CreateTagBody struct {
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
+}
+
+type CreateTagInput struct {
+	Params CreateTagParams `json:"params"`
+	Body   CreateTagBody   `json:"body"`
+}
+
+func (a *Anytype) CreateTag(ctx context.Context, input CreateTagInput) (*TagOutput, error) {
+	var output TagOutput
+
+	err := a.Post(ctx, "/v1/spaces/"+input.Params.SpaceId+"/properties/"+input.Params.PropertyId+"/tags", input.Body, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+type UpdateTagBody struct {
+	Name  string `json:"name,omitempty"`
+	Color string `json:"color,omitempty"`
+}
+
+type UpdateTagInput struct {
+	Params TagParams     `json:"params"`
+	Body   UpdateTagBody `json:"body"`
+}
+
+func (a *Anytype) UpdateTag(ctx context.Context, input UpdateTagInput) (*TagOutput, error) {
+	var output TagOutput
+
+	err := a.Patch(ctx, tagPath(input.Params), input.Body, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+type DeleteTagInput struct {
+	Params TagParams `json:"params"`
+}
+
+// DeleteTag removes the tag from the property, objects using it lose the value.
+func (a *Anytype) DeleteTag(ctx context.Context, input DeleteTagInput) (*TagOutput, error) {
+	var output TagOutput
+
+	err := a.Delete(ctx, tagPath(input.Params), &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func tagPath(params TagParams) string {
+	return "/v1/spaces/" + params.SpaceId + "/properties/" + params.PropertyId + "/tags/" + params.TagId
+}
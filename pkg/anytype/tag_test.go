@@ -0,0 +1,134 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListTags(t *testing.T) {
+	expected := ListTagsOutput{
+		Data: []Tag{
+			{ID: "tag1", Key: "todo", Name: "To Do", Color: "grey"},
+			{ID: "tag2", Key: "done", Name: "Done", Color: "lime"},
+		},
+		Pagination: Pagination{Total: 2, Offset: 0},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/v1/spaces/space1/properties/prop1/tags" {
+			t.Errorf("expected path /v1/spaces/space1/properties/prop1/tags, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(expected)
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	result, err := client.ListTags(context.Background(), ListTagsInput{
+		Params: ListTagsParams{SpaceId: "space1", PropertyId: "prop1"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(result, &expected) {
+		t.Errorf("expected %+v, got %+v", expected, *result)
+	}
+}
+
+func TestTag(t *testing.T) {
+	params := TagParams{SpaceId: "space1", PropertyId: "prop1", TagId: "tag1"}
+	expected := &TagOutput{Tag: Tag{ID: "tag1", Key: "todo", Name: "To Do", Color: "grey"}}
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		expectedBody string
+		call         func(client *Anytype) (*TagOutput, error)
+	}{
+		{
+			name:   "get tag",
+			method: http.MethodGet,
+			path:   "/v1/spaces/space1/properties/prop1/tags/tag1",
+			call: func(client *Anytype) (*TagOutput, error) {
+				return client.GetTag(context.Background(), GetTagInput{Params: params})
+			},
+		},
+		{
+			name:         "create tag",
+			method:       http.MethodPost,
+			path:         "/v1/spaces/space1/properties/prop1/tags",
+			expectedBody: `{"name":"To Do","color":"grey"}`,
+			call: func(client *Anytype) (*TagOutput, error) {
+				return client.CreateTag(context.Background(), CreateTagInput{
+					Params: CreateTagParams{SpaceId: "space1", PropertyId: "prop1"},
+					Body:   CreateTagBody{Name: "To Do", Color: "grey"},
+				})
+			},
+		},
+		{
+			name:         "update tag",
+			method:       http.MethodPatch,
+			path:         "/v1/spaces/space1/properties/prop1/tags/tag1",
+			expectedBody: `{"color":"grey"}`,
+			call: func(client *Anytype) (*TagOutput, error) {
+				return client.UpdateTag(context.Background(), UpdateTagInput{Params: params, Body: UpdateTagBody{Color: "grey"}})
+			},
+		},
+		{
+			name:   "delete tag",
+			method: http.MethodDelete,
+			path:   "/v1/spaces/space1/properties/prop1/tags/tag1",
+			call: func(client *Anytype) (*TagOutput, error) {
+				return client.DeleteTag(context.Background(), DeleteTagInput{Params: params})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tt.method {
+					t.Errorf("expected %s method, got %s", tt.method, r.Method)
+				}
+
+				if r.URL.Path != tt.path {
+					t.Errorf("expected path %s, got %s", tt.path, r.URL.Path)
+				}
+
+				if body, _ := io.ReadAll(r.Body); tt.expectedBody != "" && string(body) != tt.expectedBody {
+					t.Errorf("expected body %s, got %s", tt.expectedBody, body)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(expected)
+			}))
+			defer server.Close()
+
+			result, err := tt.call(New("test-api-key", WithApiServer(server.URL)))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(result, expected) {
+				t.Errorf("expected %+v, got %+v", *expected, *result)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package anytype
+
+import "context"
+
+type ListTemplatesParams struct {
+	SpaceId string `json:"spaceId"`
+	TypeId  string `json:"typeId"`
+	Offset  int    `json:"offset"`
+	Limit   int    `json:"limit,omitempty"`
+}
+
+type ListTemplatesInput struct {
+	Params ListTemplatesParams `json:"params"`
+}
+
+type ListTemplatesOutput struct {
+	Data       []Object   `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+func (a *Anytype) ListTemplates(ctx context.Context, input ListTemplatesInput) (*ListTemplatesOutput, error) {
+	var output ListTemplatesOutput
+
+	err := a.Get(ctx, "/v1/spaces/"+input.Params.SpaceId+"/types/"+input.Params.TypeId+"/templates?"+paginate(input.Params.Offset, input.Params.Limit), &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+type GetTemplateParams struct {
+	SpaceId    string `json:"spaceId"`
+	TypeId     string `json:"typeId"`
+	TemplateId string `json:"templateId"`
+}
+
+type GetTemplateInput struct {
+	Params GetTemplateParams `json:"params"`
+}
+
+type GetTemplateOutput struct {
+	Template Object `json:"template"`
+}
+
+func (a *Anytype) GetTemplate(ctx context.Context, input GetTemplateInput) (*GetTemplateOutput, error) {
+	var output GetTemplateOutput
+
+	err := a.Get(ctx, "/v1/spaces/"+input.Params.SpaceId+"/types/"+input.Params.TypeId+"/templates/"+input.Params.TemplateId, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
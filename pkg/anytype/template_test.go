@@ -0,0 +1,82 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListTemplates(t *testing.T) {
+	expected := ListTemplatesOutput{
+		Data: []Object{
+			{ID: "tpl1", SpaceId: "space1", Name: "Meeting Notes"},
+			{ID: "tpl2", SpaceId: "space1", Name: "ADR"},
+		},
+		Pagination: Pagination{Total: 2, Offset: 0},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/v1/spaces/space1/types/type1/templates" {
+			t.Errorf("expected path /v1/spaces/space1/types/type1/templates, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(expected)
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	result, err := client.ListTemplates(context.Background(), ListTemplatesInput{
+		Params: ListTemplatesParams{SpaceId: "space1", TypeId: "type1"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(result, &expected) {
+		t.Errorf("expected %+v, got %+v", expected, *result)
+	}
+}
+
+func TestGetTemplate(t *testing.T) {
+	expected := GetTemplateOutput{
+		Template: Object{ID: "tpl1", SpaceId: "space1", Name: "Meeting Notes", Markdown: "## Attendees\n\n## Decisions"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/v1/spaces/space1/types/type1/templates/tpl1" {
+			t.Errorf("expected path /v1/spaces/space1/types/type1/templates/tpl1, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(expected)
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	result, err := client.GetTemplate(context.Background(), GetTemplateInput{
+		Params: GetTemplateParams{SpaceId: "space1", TypeId: "type1", TemplateId: "tpl1"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(result, &expected) {
+		t.Errorf("expected %+v, got %+v", expected, *result)
+	}
+}
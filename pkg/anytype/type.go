@@ -0,0 +1,53 @@
+package anytype
+
+import "context"
+
+type ListTypesParams struct {
+	SpaceId string `json:"spaceId"`
+	Offset  int    `json:"offset"`
+	Limit   int    `json:"limit,omitempty"`
+}
+
+type ListTypesInput struct {
+	Params ListTypesParams `json:"params"`
+}
+
+type ListTypesOutput struct {
+	Data       []ObjectType `json:"data"`
+	Pagination Pagination   `json:"pagination"`
+}
+
+func (a *Anytype) ListTypes(ctx context.Context, input ListTypesInput) (*ListTypesOutput, error) {
+	var output ListTypesOutput
+
+	err := a.Get(ctx, "/v1/spaces/"+input.Params.SpaceId+"/types?"+paginate(input.Params.Offset, input.Params.Limit), &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+type GetTypeParams struct {
+	SpaceId string `json:"spaceId"`
+	TypeId  string `json:"typeId"`
+}
+
+type GetTypeInput struct {
+	Params GetTypeParams `json:"params"`
+}
+
+type GetTypeOutput struct {
+	Type ObjectType `json:"type"`
+}
+
+func (a *Anytype) GetType(ctx context.Context, input GetTypeInput) (*GetTypeOutput, error) {
+	var output GetTypeOutput
+
+	err := a.Get(ctx, "/v1/spaces/"+input.Params.SpaceId+"/types/"+input.Params.TypeId, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,125 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListTypes_Success(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          ListTypesInput
+		mockResponse   ListTypesOutput
+		expectedPath   string
+		expectedParams string
+	}{
+		{
+			name: "types with properties",
+			input: ListTypesInput{
+				Params: ListTypesParams{SpaceId: "space1"},
+			},
+			mockResponse: ListTypesOutput{
+				Data: []ObjectType{
+					{
+						ID:     "type1",
+						Key:    "task",
+						Name:   "Task",
+						Layout: "action",
+						Properties: []PropertyDefinition{
+							{ID: "prop1", Key: "status", Name: "Status", Format: "select"},
+							{ID: "prop2", Key: "done", Name: "Done", Format: "checkbox"},
+						},
+					},
+				},
+				Pagination: Pagination{Total: 1, Offset: 0},
+			},
+			expectedPath:   "/v1/spaces/space1/types",
+			expectedParams: "offset=0",
+		},
+		{
+			name: "types with offset and limit",
+			input: ListTypesInput{
+				Params: ListTypesParams{SpaceId: "space2", Offset: 50, Limit: 25},
+			},
+			mockResponse: ListTypesOutput{
+				Data:       []ObjectType{{ID: "type51", Key: "page", Name: "Page"}},
+				Pagination: Pagination{Total: 51, Offset: 50, Limit: 25},
+			},
+			expectedPath:   "/v1/spaces/space2/types",
+			expectedParams: "limit=25&offset=50",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.expectedPath {
+					t.Errorf("expected path %s, got %s", tt.expectedPath, r.URL.Path)
+				}
+
+				if r.URL.RawQuery != tt.expectedParams {
+					t.Errorf("expected query params %s, got %s", tt.expectedParams, r.URL.RawQuery)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(tt.mockResponse)
+			}))
+			defer server.Close()
+
+			client := New("test-api-key", WithApiServer(server.URL))
+			result, err := client.ListTypes(context.Background(), tt.input)
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(result, &tt.mockResponse) {
+				t.Errorf("expected %+v, got %+v", tt.mockResponse, *result)
+			}
+		})
+	}
+}
+
+func TestGetType_Success(t *testing.T) {
+	expected := GetTypeOutput{
+		Type: ObjectType{
+			ID:   "type1",
+			Key:  "task",
+			Name: "Task",
+			Properties: []PropertyDefinition{
+				{ID: "prop1", Key: "status", Name: "Status", Format: "select"},
+			},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/spaces/space1/types/type1" {
+			t.Errorf("expected path /v1/spaces/space1/types/type1, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(expected)
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithApiServer(server.URL))
+	result, err := client.GetType(context.Background(), GetTypeInput{
+		Params: GetTypeParams{SpaceId: "space1", TypeId: "type1"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(result, &expected) {
+		t.Errorf("expected %+v, got %+v", expected, *result)
+	}
+}
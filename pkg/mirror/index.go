@@ -0,0 +1,134 @@
+package mirror
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// BM25 parameters, see https://en.wikipedia.org/wiki/Okapi_BM25
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// index is an in-memory inverted index ranks documents by BM25.
+type index struct {
+	docs     map[string]map[string]int
+	lengths  map[string]int
+	postings map[string]map[string]int
+	length   int
+}
+
+func newIndex() *index {
+	return &index{
+		docs:     make(map[string]map[string]int),
+		lengths:  make(map[string]int),
+		postings: make(map[string]map[string]int),
+	}
+}
+
+func (ix *index) add(doc, text string) {
+	ix.remove(doc)
+
+	tokens := tokenize(text)
+	terms := make(map[string]int)
+	for _, token := range tokens {
+		terms[token]++
+	}
+
+	for term, frequency := range terms {
+		if ix.postings[term] == nil {
+			ix.postings[term] = make(map[string]int)
+		}
+		ix.postings[term][doc] = frequency
+	}
+
+	ix.docs[doc] = terms
+	ix.lengths[doc] = len(tokens)
+	ix.length += len(tokens)
+}
+
+func (ix *index) remove(doc string) {
+	terms, ok := ix.docs[doc]
+	if !ok {
+		return
+	}
+
+	for term := range terms {
+		delete(ix.postings[term], doc)
+		if len(ix.postings[term]) == 0 {
+			delete(ix.postings, term)
+		}
+	}
+
+	ix.length -= ix.lengths[doc]
+	delete(ix.docs, doc)
+	delete(ix.lengths, doc)
+}
+
+// search returns the BM25 score of the documents contain any term of the query.
+func (ix *index) search(query string) map[string]float64 {
+	scores := make(map[string]float64)
+	if len(ix.docs) == 0 {
+		return scores
+	}
+
+	total := float64(len(ix.docs))
+	average := float64(ix.length) / total
+
+	seen := make(map[string]bool)
+	for _, term := range tokenize(query) {
+		if seen[term] {
+			continue
+		}
+		seen[term] = true
+
+		postings := ix.postings[term]
+		if len(postings) == 0 {
+			continue
+		}
+
+		matched := float64(len(postings))
+		idf := math.Log(1 + (total-matched+0.5)/(matched+0.5))
+		for doc, frequency := range postings {
+			tf := float64(frequency)
+			length := float64(ix.lengths[doc])
+			scores[doc] += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*length/average))
+		}
+	}
+
+	return scores
+}
+
+// tokenize splits the text into lower case words, the CJK characters are indexed one by one since they are not separated by spaces.
+func tokenize(text string) []string {
+	var tokens []string
+	var word strings.Builder
+
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isCJK(r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
@@ -0,0 +1,61 @@
+package mirror
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{"words", "Hello, World! go1.24", []string{"hello", "world", "go1", "24"}},
+		{"cjk", "會議 notes", []string{"會", "議", "notes"}},
+		{"empty", "  --  ", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := tokenize(tt.text)
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIndex_Search(t *testing.T) {
+	ix := newIndex()
+	ix.add("a", "golang concurrency patterns with channels")
+	ix.add("b", "cooking recipes")
+	ix.add("c", "golang golang golang generics")
+	ix.add("d", "channels and pipelines")
+
+	scores := ix.search("golang")
+	if len(scores) != 2 {
+		t.Fatalf("expected 2 matched documents, got %v", scores)
+	}
+
+	if scores["c"] <= scores["a"] {
+		t.Errorf("expected higher term frequency to rank higher, got %v", scores)
+	}
+
+	scores = ix.search("golang channels")
+	if scores["a"] <= scores["c"] || scores["a"] <= scores["d"] {
+		t.Errorf("expected document matching both terms to rank first, got %v", scores)
+	}
+
+	ix.remove("a")
+	if _, ok := ix.search("concurrency")["a"]; ok {
+		t.Error("expected removed document not to be matched")
+	}
+
+	ix.add("c", "cooking")
+	if len(ix.search("golang")) != 0 {
+		t.Error("expected replaced document to drop the previous terms")
+	}
+}
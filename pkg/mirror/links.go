@@ -0,0 +1,68 @@
+package mirror
+
+import (
+	"slices"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+)
+
+// LinksFunc returns the ids of the objects linked by the object.
+type LinksFunc func(object *anytype.Object) []string
+
+// linkIndex is an in-memory reverse index of the links between the objects of a space.
+type linkIndex struct {
+	linksOf   LinksFunc
+	targets   map[string][]string
+	backlinks map[string]map[string]bool
+}
+
+func newLinkIndex(linksOf LinksFunc) *linkIndex {
+	return &linkIndex{
+		linksOf:   linksOf,
+		targets:   make(map[string][]string),
+		backlinks: make(map[string]map[string]bool),
+	}
+}
+
+func (ix *linkIndex) add(spaceId string, object *Object) {
+	ix.remove(spaceId, object.ID)
+	if ix.linksOf == nil {
+		return
+	}
+
+	var targets []string
+	for _, id := range ix.linksOf(&object.Object) {
+		target := docKey(spaceId, id)
+		if ix.backlinks[target] == nil {
+			ix.backlinks[target] = make(map[string]bool)
+		}
+
+		ix.backlinks[target][object.ID] = true
+		targets = append(targets, target)
+	}
+
+	ix.targets[docKey(spaceId, object.ID)] = targets
+}
+
+func (ix *linkIndex) remove(spaceId, objectId string) {
+	doc := docKey(spaceId, objectId)
+	for _, target := range ix.targets[doc] {
+		delete(ix.backlinks[target], objectId)
+		if len(ix.backlinks[target]) == 0 {
+			delete(ix.backlinks, target)
+		}
+	}
+
+	delete(ix.targets, doc)
+}
+
+// sources returns the ids of the objects in the space linking to the object sorted by id.
+func (ix *linkIndex) sources(spaceId, objectId string) []string {
+	sources := make([]string, 0, len(ix.backlinks[docKey(spaceId, objectId)]))
+	for id := range ix.backlinks[docKey(spaceId, objectId)] {
+		sources = append(sources, id)
+	}
+
+	slices.Sort(sources)
+	return sources
+}
@@ -0,0 +1,331 @@
+package mirror
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/internal/jsonfile"
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+)
+
+// DefaultLimit is the maximum search results when the limit is not given.
+const DefaultLimit = 100
+
+// Object is a mirrored object with the last modified date used by incremental sync.
+type Object struct {
+	anytype.Object
+	LastModified time.Time `json:"last_modified_date"`
+}
+
+// Space is the mirrored objects of a space.
+type Space struct {
+	ID           string             `json:"id"`
+	Name         string             `json:"name"`
+	SyncedAt     time.Time          `json:"synced_at"`
+	LastModified time.Time          `json:"last_modified_date"`
+	Objects      map[string]*Object `json:"objects"`
+}
+
+type snapshot struct {
+	Spaces map[string]*Space `json:"spaces"`
+}
+
+// Store keeps the mirrored objects in a JSON file and searches them with a full-text index.
+type Store struct {
+	path    string
+	file    *jsonfile.File
+	mu      sync.RWMutex
+	data    snapshot
+	index   *index
+	links   *linkIndex
+	linksOf LinksFunc
+}
+
+// Open loads the mirror file, the store is empty when the file does not exist.
+func Open(path string) (*Store, error) {
+	store := &Store{path: path, file: jsonfile.New(path)}
+	if err := store.load(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *Store) load() error {
+	data := snapshot{Spaces: make(map[string]*Space)}
+	if err := s.file.Load(&data); err != nil {
+		return err
+	}
+
+	s.data = data
+	s.index = newIndex()
+	s.links = newLinkIndex(s.linksOf)
+	for _, space := range s.data.Spaces {
+		for _, object := range space.Objects {
+			s.index.add(docKey(space.ID, object.ID), searchText(object))
+			s.links.add(space.ID, object)
+		}
+	}
+
+	return nil
+}
+
+// Refresh reloads the mirror file when it is changed by another sync process.
+func (s *Store) Refresh() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed, err := s.file.Changed()
+	if err != nil || !changed {
+		return err
+	}
+
+	return s.load()
+}
+
+// Save writes the mirror file atomically which is only readable by the user.
+func (s *Store) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Save(s.data)
+}
+
+// Object returns the mirrored object and the sync time of its space, all spaces are looked up when the space id is empty.
+func (s *Store) Object(spaceId, objectId string) (*Object, time.Time, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, space := range s.data.Spaces {
+		if spaceId != "" && space.ID != spaceId {
+			continue
+		}
+
+		if object, ok := space.Objects[objectId]; ok {
+			return object, space.SyncedAt, true
+		}
+	}
+
+	return nil, time.Time{}, false
+}
+
+// Objects returns the mirrored objects of the space.
+func (s *Store) Objects(spaceId string) []*Object {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	space, ok := s.data.Spaces[spaceId]
+	if !ok {
+		return nil
+	}
+
+	objects := make([]*Object, 0, len(space.Objects))
+	for _, object := range space.Objects {
+		objects = append(objects, object)
+	}
+
+	return objects
+}
+
+// IndexLinks builds the reverse link index with the links of each object, which is kept up to date on sync and reload.
+func (s *Store) IndexLinks(linksOf LinksFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.linksOf = linksOf
+	s.links = newLinkIndex(linksOf)
+	for _, space := range s.data.Spaces {
+		for _, object := range space.Objects {
+			s.links.add(space.ID, object)
+		}
+	}
+}
+
+// Backlinks returns the ids of the mirrored objects in the space linking to the object sorted by id, it is empty until the links are indexed.
+func (s *Store) Backlinks(spaceId, objectId string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.links.sources(spaceId, objectId)
+}
+
+// Query is the search condition of the mirror.
+type Query struct {
+	Text    string
+	SpaceId string
+	Types   []string
+	Offset  int
+	Limit   int
+}
+
+// SearchResult is a page of matched objects ranked by relevance, or by last modified date without query text.
+type SearchResult struct {
+	Data     []*Object
+	Total    int
+	SyncedAt time.Time
+}
+
+// Search finds the objects by BM25 full-text ranking, the SyncedAt is the oldest sync time of the searched spaces.
+func (s *Store) Search(query Query) SearchResult {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var scores map[string]float64
+	if query.Text != "" {
+		scores = s.index.search(query.Text)
+	}
+
+	type hit struct {
+		object *Object
+		score  float64
+	}
+
+	var result SearchResult
+	var hits []hit
+	for _, space := range s.data.Spaces {
+		if query.SpaceId != "" && space.ID != query.SpaceId {
+			continue
+		}
+
+		if result.SyncedAt.IsZero() || space.SyncedAt.Before(result.SyncedAt) {
+			result.SyncedAt = space.SyncedAt
+		}
+
+		for _, object := range space.Objects {
+			if len(query.Types) > 0 && !slices.Contains(query.Types, object.Type.Key) {
+				continue
+			}
+
+			score, ok := scores[docKey(space.ID, object.ID)]
+			if query.Text != "" && !ok {
+				continue
+			}
+
+			hits = append(hits, hit{object: object, score: score})
+		}
+	}
+
+	slices.SortFunc(hits, func(a, b hit) int {
+		return cmp.Or(
+			cmp.Compare(b.score, a.score),
+			b.object.LastModified.Compare(a.object.LastModified),
+			cmp.Compare(a.object.ID, b.object.ID),
+		)
+	})
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	result.Total = len(hits)
+	for _, hit := range hits[min(query.Offset, len(hits)):min(query.Offset+limit, len(hits))] {
+		result.Data = append(result.Data, hit.object)
+	}
+
+	return result
+}
+
+// Spaces returns the mirrored spaces without objects.
+func (s *Store) Spaces() []Space {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	spaces := make([]Space, 0, len(s.data.Spaces))
+	for _, space := range s.data.Spaces {
+		spaces = append(spaces, Space{ID: space.ID, Name: space.Name, SyncedAt: space.SyncedAt, LastModified: space.LastModified})
+	}
+
+	slices.SortFunc(spaces, func(a, b Space) int { return cmp.Compare(a.ID, b.ID) })
+	return spaces
+}
+
+func (s *Store) space(id string) Space {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if space, ok := s.data.Spaces[id]; ok {
+		return *space
+	}
+
+	return Space{ID: id}
+}
+
+// putSpace replaces the space info, the objects are cleared when reset.
+func (s *Store) putSpace(info Space, reset bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	space, ok := s.data.Spaces[info.ID]
+	if !ok {
+		space = &Space{ID: info.ID, Objects: make(map[string]*Object)}
+		s.data.Spaces[info.ID] = space
+	}
+
+	if reset {
+		for id := range space.Objects {
+			s.index.remove(docKey(space.ID, id))
+			s.links.remove(space.ID, id)
+		}
+		space.Objects = make(map[string]*Object)
+	}
+
+	space.Name = info.Name
+	space.SyncedAt = info.SyncedAt
+	space.LastModified = info.LastModified
+}
+
+func (s *Store) putObject(spaceId string, object *Object) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	space, ok := s.data.Spaces[spaceId]
+	if !ok {
+		space = &Space{ID: spaceId, Objects: make(map[string]*Object)}
+		s.data.Spaces[spaceId] = space
+	}
+
+	if space.Objects == nil {
+		space.Objects = make(map[string]*Object)
+	}
+
+	space.Objects[object.ID] = object
+	s.index.add(docKey(spaceId, object.ID), searchText(object))
+	s.links.add(spaceId, object)
+}
+
+// prune drops the objects of the space which are not listed and returns the number of dropped objects.
+func (s *Store) prune(spaceId string, listed map[string]bool) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	space, ok := s.data.Spaces[spaceId]
+	if !ok {
+		return 0
+	}
+
+	removed := 0
+	for id := range space.Objects {
+		if listed[id] {
+			continue
+		}
+
+		delete(space.Objects, id)
+		s.index.remove(docKey(spaceId, id))
+		s.links.remove(spaceId, id)
+		removed++
+	}
+
+	return removed
+}
+
+func docKey(spaceId, objectId string) string {
+	return spaceId + "/" + objectId
+}
+
+// searchText is the indexed text of the object, the name is repeated to rank higher than the body.
+func searchText(object *Object) string {
+	return object.Name + "\n" + object.Name + "\n" + object.Markdown
+}
@@ -0,0 +1,202 @@
+package mirror
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+
+	store, err := Open(filepath.Join(t.TempDir(), "mirror.json"))
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+
+	syncedAt := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+	store.putSpace(Space{ID: "space1", Name: "Work", SyncedAt: syncedAt}, false)
+	store.putSpace(Space{ID: "space2", Name: "Home", SyncedAt: syncedAt.Add(-time.Hour)}, false)
+
+	objects := []struct {
+		spaceId  string
+		id       string
+		name     string
+		typeKey  string
+		markdown string
+		modified time.Time
+	}{
+		{"space1", "obj1", "Meeting Notes", "page", "Discuss the roadmap", syncedAt.Add(-3 * time.Hour)},
+		{"space1", "obj2", "Roadmap", "page", "Quarterly roadmap and goals", syncedAt.Add(-2 * time.Hour)},
+		{"space1", "obj3", "Buy milk", "task", "", syncedAt.Add(-time.Hour)},
+		{"space2", "obj4", "Garden", "page", "Plant tomatoes", syncedAt.Add(-4 * time.Hour)},
+	}
+
+	for _, o := range objects {
+		store.putObject(o.spaceId, &Object{
+			Object: anytype.Object{
+				ID:       o.id,
+				SpaceId:  o.spaceId,
+				Name:     o.name,
+				Markdown: o.markdown,
+				Type:     anytype.ObjectType{Key: o.typeKey},
+			},
+			LastModified: o.modified,
+		})
+	}
+
+	return store
+}
+
+func ids(objects []*Object) []string {
+	result := make([]string, len(objects))
+	for i, object := range objects {
+		result[i] = object.ID
+	}
+
+	return result
+}
+
+func TestStore_Search(t *testing.T) {
+	store := newTestStore(t)
+
+	tests := []struct {
+		name          string
+		query         Query
+		expectedIds   []string
+		expectedTotal int
+	}{
+		{"ranked by relevance", Query{Text: "roadmap"}, []string{"obj2", "obj1"}, 2},
+		{"without text sorted by last modified", Query{SpaceId: "space1"}, []string{"obj3", "obj2", "obj1"}, 3},
+		{"filtered by type", Query{Types: []string{"task"}}, []string{"obj3"}, 1},
+		{"filtered by space", Query{Text: "tomatoes", SpaceId: "space1"}, nil, 0},
+		{"paginated", Query{Offset: 1, Limit: 2}, []string{"obj2", "obj1"}, 4},
+		{"offset beyond total", Query{Offset: 10}, nil, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := store.Search(tt.query)
+			if actual := ids(result.Data); !slices.Equal(actual, tt.expectedIds) {
+				t.Errorf("expected ids %v, got %v", tt.expectedIds, actual)
+			}
+
+			if result.Total != tt.expectedTotal {
+				t.Errorf("expected total %d, got %d", tt.expectedTotal, result.Total)
+			}
+		})
+	}
+}
+
+func TestStore_Backlinks(t *testing.T) {
+	store := newTestStore(t)
+
+	// the markdown of the test objects is the linked ids
+	store.IndexLinks(func(object *anytype.Object) []string { return strings.Fields(object.Markdown) })
+
+	link := func(spaceId, id, markdown string) *Object {
+		return &Object{Object: anytype.Object{ID: id, SpaceId: spaceId, Markdown: markdown}}
+	}
+
+	store.putObject("space1", link("space1", "obj5", "obj2"))
+	store.putObject("space1", link("space1", "obj1", "obj2 obj3"))
+	store.putObject("space2", link("space2", "obj6", "obj2"))
+
+	if backlinks := store.Backlinks("space1", "obj2"); !slices.Equal(backlinks, []string{"obj1", "obj5"}) {
+		t.Errorf("expected backlinks [obj1 obj5], got %v", backlinks)
+	}
+
+	store.putObject("space1", link("space1", "obj5", ""))
+	store.prune("space1", map[string]bool{"obj2": true, "obj3": true, "obj5": true})
+
+	if backlinks := store.Backlinks("space1", "obj2"); len(backlinks) != 0 {
+		t.Errorf("expected backlinks of changed and pruned objects dropped, got %v", backlinks)
+	}
+}
+
+func TestStore_SaveAndOpen(t *testing.T) {
+	store := newTestStore(t)
+	if err := store.Save(); err != nil {
+		t.Fatalf("failed to save store: %v", err)
+	}
+
+	info, err := os.Stat(store.path)
+	if err != nil {
+		t.Fatalf("expected mirror file, got %v", err)
+	}
+
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("expected mirror file permission 0600, got %o", perm)
+	}
+
+	reopened, err := Open(store.path)
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+
+	object, syncedAt, ok := reopened.Object("", "obj4")
+	if !ok {
+		t.Fatal("expected object obj4 in reopened store")
+	}
+
+	if object.Name != "Garden" || object.SpaceId != "space2" {
+		t.Errorf("expected object Garden in space2, got %+v", object.Object)
+	}
+
+	if !syncedAt.Equal(time.Date(2025, 5, 20, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected space synced at 11:00, got %v", syncedAt)
+	}
+
+	if result := reopened.Search(Query{Text: "quarterly"}); len(result.Data) != 1 {
+		t.Errorf("expected index rebuilt on open, got %v", ids(result.Data))
+	}
+}
+
+func TestStore_Refresh(t *testing.T) {
+	store := newTestStore(t)
+	if err := store.Save(); err != nil {
+		t.Fatalf("failed to save store: %v", err)
+	}
+
+	reader, err := Open(store.path)
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+
+	store.putObject("space1", &Object{Object: anytype.Object{ID: "obj5", SpaceId: "space1", Name: "New"}})
+	if err := store.Save(); err != nil {
+		t.Fatalf("failed to save store: %v", err)
+	}
+
+	// make sure the modification time is changed on file systems with coarse timestamps
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(store.path, future, future); err != nil {
+		t.Fatalf("failed to change modification time: %v", err)
+	}
+
+	if err := reader.Refresh(); err != nil {
+		t.Fatalf("failed to refresh store: %v", err)
+	}
+
+	if _, _, ok := reader.Object("space1", "obj5"); !ok {
+		t.Error("expected refreshed store to contain the new object")
+	}
+}
+
+func TestOpen_Missing(t *testing.T) {
+	store, err := Open(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result := store.Search(Query{}); result.Total != 0 {
+		t.Errorf("expected empty store, got %d objects", result.Total)
+	}
+}
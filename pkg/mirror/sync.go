@@ -0,0 +1,100 @@
+package mirror
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+)
+
+// syncPageSize is the number of objects fetched per search request during sync.
+const syncPageSize = 100
+
+// SyncReport summarizes the changes of a sync.
+type SyncReport struct {
+	Spaces  int
+	Objects int
+	Removed int
+}
+
+// Sync mirrors the objects of all spaces into the store and saves it.
+// Only the objects modified since the last sync are fetched unless full is true, the objects deleted or archived upstream are dropped.
+func Sync(ctx context.Context, client *anytype.Anytype, store *Store, full bool) (*SyncReport, error) {
+	report := &SyncReport{}
+
+	for space, err := range client.ListSpacesAll(ctx, anytype.ListSpacesInput{}, 0) {
+		if err != nil {
+			return nil, fmt.Errorf("list spaces: %w", err)
+		}
+
+		count, removed, err := syncSpace(ctx, client, store, space, full)
+		if err != nil {
+			return nil, fmt.Errorf("sync space %s: %w", space.Name, err)
+		}
+
+		report.Spaces++
+		report.Objects += count
+		report.Removed += removed
+	}
+
+	if err := store.Save(); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// syncSpace lists the objects of the space to drop the ones no longer exist, and fetches the objects
+// modified after the last sync. Objects without last modified date are always fetched.
+func syncSpace(ctx context.Context, client *anytype.Anytype, store *Store, space anytype.Space, full bool) (int, int, error) {
+	info := store.space(space.ID)
+	if full {
+		info.LastModified = time.Time{}
+	}
+	since := info.LastModified
+
+	info.Name = space.Name
+	info.SyncedAt = time.Now()
+
+	var objects []*Object
+	listed := make(map[string]bool)
+	search := anytype.SearchInput{
+		Params: anytype.SearchParams{SpaceId: space.ID, Limit: syncPageSize},
+		Body: anytype.SearchBody{
+			Sort: &anytype.SearchSort{PropertyKey: "last_modified_date", Direction: "desc"},
+		},
+	}
+
+	for item, err := range client.SearchAll(ctx, search, 0) {
+		if err != nil {
+			return 0, 0, err
+		}
+		listed[item.ID] = true
+
+		modified := item.LastModified()
+		if !since.IsZero() && !modified.IsZero() && !modified.After(since) {
+			continue
+		}
+
+		object, err := client.GetObject(ctx, anytype.GetObjectInput{
+			Params: anytype.GetObjectParams{SpaceId: space.ID, ObjectId: item.ID},
+		})
+		if err != nil {
+			return 0, 0, fmt.Errorf("get object %s: %w", item.ID, err)
+		}
+
+		objects = append(objects, &Object{Object: object.Object, LastModified: modified})
+		if modified.After(info.LastModified) {
+			info.LastModified = modified
+		}
+	}
+
+	store.putSpace(info, full)
+	removed := store.prune(space.ID, listed)
+	for _, object := range objects {
+		store.putObject(space.ID, object)
+	}
+
+	return len(objects), removed, nil
+}
@@ -0,0 +1,189 @@
+package mirror
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/internal/anytypetest"
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+)
+
+func TestSync(t *testing.T) {
+	base := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+
+	fake := &anytypetest.Server{}
+	fake.Add("obj1", "First", "# First", base)
+	fake.Add("obj2", "Second", "# Second", base.Add(time.Hour))
+
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	store, err := Open(filepath.Join(t.TempDir(), "mirror.json"))
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+
+	report, err := Sync(context.Background(), client, store, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if report.Spaces != 1 || report.Objects != 2 {
+		t.Errorf("expected 2 objects in 1 space, got %+v", report)
+	}
+
+	if fetched := fake.TakeFetched(); len(fetched) != 2 {
+		t.Errorf("expected all objects fetched on first sync, got %v", fetched)
+	}
+
+	fake.Add("obj3", "Third", "# Third", base.Add(2*time.Hour))
+
+	report, err = Sync(context.Background(), client, store, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if fetched := fake.TakeFetched(); strings.Join(fetched, ",") != "obj3" {
+		t.Errorf("expected only modified objects fetched, got %v", fetched)
+	}
+
+	if report.Objects != 1 {
+		t.Errorf("expected 1 object synced, got %d", report.Objects)
+	}
+
+	reopened, err := Open(store.path)
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+
+	object, _, ok := reopened.Object("space1", "obj3")
+	if !ok {
+		t.Fatal("expected synced object in saved mirror")
+	}
+
+	if object.Markdown != "# Third" || !object.LastModified.Equal(base.Add(2*time.Hour)) {
+		t.Errorf("expected mirrored markdown and last modified date, got %+v", object)
+	}
+
+	if space := reopened.space("space1"); space.Name != "Work" || !space.LastModified.Equal(base.Add(2*time.Hour)) {
+		t.Errorf("expected space last modified date updated, got %+v", space)
+	}
+
+	if result := reopened.Search(Query{Text: "first"}); len(result.Data) != 1 || result.Data[0].ID != "obj1" {
+		t.Errorf("expected object searchable, got %v", ids(result.Data))
+	}
+}
+
+func TestSync_Prune(t *testing.T) {
+	base := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+
+	fake := &anytypetest.Server{}
+	fake.Add("obj1", "First", "# First", base)
+	fake.Add("obj2", "Second", "# Second", base.Add(time.Hour))
+
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	store, err := Open(filepath.Join(t.TempDir(), "mirror.json"))
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+
+	if _, err := Sync(context.Background(), client, store, false); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	fake.TakeFetched()
+
+	fake.Remove("obj1")
+
+	report, err := Sync(context.Background(), client, store, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if fetched := fake.TakeFetched(); len(fetched) != 0 {
+		t.Errorf("expected no objects fetched, got %v", fetched)
+	}
+
+	if report.Removed != 1 {
+		t.Errorf("expected 1 object removed, got %d", report.Removed)
+	}
+
+	if _, _, ok := store.Object("space1", "obj1"); ok {
+		t.Error("expected sync to drop the deleted object")
+	}
+
+	if result := store.Search(Query{Text: "first"}); len(result.Data) != 0 {
+		t.Errorf("expected deleted object not searchable, got %v", ids(result.Data))
+	}
+}
+
+func TestSync_Undated(t *testing.T) {
+	base := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+
+	fake := &anytypetest.Server{}
+	fake.Add("obj1", "First", "# First", base)
+	fake.Add("obj2", "Second", "# Second", base.Add(time.Hour))
+
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	store, err := Open(filepath.Join(t.TempDir(), "mirror.json"))
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+
+	if _, err := Sync(context.Background(), client, store, false); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	fake.TakeFetched()
+
+	// an object without last modified date listed before the dated objects must not stop the sync
+	fake.Add("undated", "Undated", "# Undated", time.Time{})
+	fake.Add("obj3", "Third", "# Third", base.Add(2*time.Hour))
+
+	if _, err := Sync(context.Background(), client, store, false); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if fetched := fake.TakeFetched(); strings.Join(fetched, ",") != "obj3,undated" {
+		t.Errorf("expected the undated and modified objects fetched, got %v", fetched)
+	}
+}
+
+func TestSync_Full(t *testing.T) {
+	base := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+
+	fake := &anytypetest.Server{}
+	fake.Add("obj1", "First", "# First", base)
+
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	store, err := Open(filepath.Join(t.TempDir(), "mirror.json"))
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+
+	store.putObject("space1", &Object{Object: anytype.Object{ID: "deleted", SpaceId: "space1"}})
+
+	if _, err := Sync(context.Background(), client, store, true); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, _, ok := store.Object("space1", "deleted"); ok {
+		t.Error("expected full sync to drop objects no longer exist")
+	}
+
+	if _, _, ok := store.Object("space1", "obj1"); !ok {
+		t.Error("expected full sync to mirror the existing objects")
+	}
+}
@@ -0,0 +1,49 @@
+package semantic
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// chunkSize is the maximum characters of a chunk, it keeps the chunks focus on a topic and fit small embedding models.
+const chunkSize = 800
+
+// chunkMarkdown splits the markdown by paragraphs and merges the short paragraphs up to the size,
+// the paragraphs longer than the size are split by characters.
+func chunkMarkdown(markdown string, size int) []string {
+	var chunks []string
+	var current strings.Builder
+
+	flush := func() {
+		if text := strings.TrimSpace(current.String()); text != "" {
+			chunks = append(chunks, text)
+		}
+		current.Reset()
+	}
+
+	for _, paragraph := range strings.Split(markdown, "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+
+		if current.Len() > 0 && utf8.RuneCountInString(current.String())+utf8.RuneCountInString(paragraph)+2 > size {
+			flush()
+		}
+
+		for utf8.RuneCountInString(paragraph) > size {
+			flush()
+			runes := []rune(paragraph)
+			chunks = append(chunks, string(runes[:size]))
+			paragraph = strings.TrimSpace(string(runes[size:]))
+		}
+
+		if current.Len() > 0 {
+			current.WriteString("\n\n")
+		}
+		current.WriteString(paragraph)
+	}
+	flush()
+
+	return chunks
+}
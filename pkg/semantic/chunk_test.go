@@ -0,0 +1,33 @@
+package semantic
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestChunkMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		size     int
+		expected []string
+	}{
+		{"empty", "", 20, nil},
+		{"merge short paragraphs", "# Title\n\nfirst\n\nsecond", 30, []string{"# Title\n\nfirst\n\nsecond"}},
+		{"split at paragraph", "aaaaaaaaaa\n\nbbbbbbbbbb\n\ncc", 15, []string{"aaaaaaaaaa", "bbbbbbbbbb\n\ncc"}},
+		{"split long paragraph", "short\n\n" + strings.Repeat("x", 25), 10, []string{"short", "xxxxxxxxxx", "xxxxxxxxxx", "xxxxx"}},
+		{"count characters", "會議紀錄\n\n決定", 6, []string{"會議紀錄", "決定"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := chunkMarkdown(tt.markdown, tt.size)
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %q, got %q", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package semantic
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Embedder converts texts into vectors, the vectors of similar texts should have high cosine similarity.
+type Embedder interface {
+	// Name identifies the embedding model, the index is rebuilt when it is changed.
+	Name() string
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// DefaultHashDimensions is the vector size of the hash embedder when the size is not given.
+const DefaultHashDimensions = 512
+
+var _ Embedder = &HashEmbedder{}
+
+// HashEmbedder embeds the words and character trigrams by feature hashing, it works offline without any model.
+type HashEmbedder struct {
+	dimensions int
+}
+
+func NewHashEmbedder(dimensions int) *HashEmbedder {
+	if dimensions <= 0 {
+		dimensions = DefaultHashDimensions
+	}
+
+	return &HashEmbedder{dimensions: dimensions}
+}
+
+func (e *HashEmbedder) Name() string {
+	return "hash-" + strconv.Itoa(e.dimensions)
+}
+
+func (e *HashEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = e.embed(text)
+	}
+
+	return vectors, nil
+}
+
+func (e *HashEmbedder) embed(text string) []float32 {
+	counts := make(map[string]int)
+	for _, word := range words(text) {
+		counts[word]++
+
+		// the trigrams match the variants of a word, e.g. plan and planning
+		runes := []rune("^" + word + "$")
+		for i := 0; i+3 <= len(runes); i++ {
+			counts[string(runes[i:i+3])]++
+		}
+	}
+
+	vector := make([]float32, e.dimensions)
+	for feature, count := range counts {
+		hash := fnv.New64a()
+		hash.Write([]byte(feature))
+		sum := hash.Sum64()
+
+		weight := float32(1 + math.Log(float64(count)))
+		if sum&1 == 1 {
+			weight = -weight
+		}
+
+		vector[(sum>>1)%uint64(e.dimensions)] += weight
+	}
+
+	return normalize(vector)
+}
+
+// words splits the text into lower case words, the trigrams of CJK text work as words since they are not separated by spaces.
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func normalize(vector []float32) []float32 {
+	var norm float64
+	for _, v := range vector {
+		norm += float64(v) * float64(v)
+	}
+
+	if norm == 0 {
+		return vector
+	}
+
+	scale := float32(1 / math.Sqrt(norm))
+	for i := range vector {
+		vector[i] *= scale
+	}
+
+	return vector
+}
+
+// cosine returns the cosine similarity of the vectors.
+func cosine(a, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / math.Sqrt(normA*normB)
+}
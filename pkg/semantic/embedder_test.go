@@ -0,0 +1,53 @@
+package semantic
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+func TestHashEmbedder(t *testing.T) {
+	embedder := NewHashEmbedder(0)
+
+	vectors, err := embedder.Embed(context.Background(), []string{
+		"planning the quarterly roadmap",
+		"roadmap plans for next quarter",
+		"chocolate cake recipe",
+		"",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(vectors[0]) != DefaultHashDimensions {
+		t.Errorf("expected %d dimensions, got %d", DefaultHashDimensions, len(vectors[0]))
+	}
+
+	var norm float64
+	for _, v := range vectors[0] {
+		norm += float64(v) * float64(v)
+	}
+	if math.Abs(norm-1) > 1e-5 {
+		t.Errorf("expected normalized vector, got norm %f", norm)
+	}
+
+	related, unrelated := cosine(vectors[0], vectors[1]), cosine(vectors[0], vectors[2])
+	if related <= unrelated {
+		t.Errorf("expected related texts more similar, got related %f and unrelated %f", related, unrelated)
+	}
+
+	if score := cosine(vectors[0], vectors[3]); score != 0 {
+		t.Errorf("expected empty text to have zero similarity, got %f", score)
+	}
+
+	again, _ := embedder.Embed(context.Background(), []string{"planning the quarterly roadmap"})
+	if cosine(vectors[0], again[0]) < 0.9999 {
+		t.Error("expected embedding to be deterministic")
+	}
+}
+
+func TestHashEmbedder_Name(t *testing.T) {
+	if NewHashEmbedder(128).Name() == NewHashEmbedder(256).Name() {
+		t.Error("expected name to change with dimensions to rebuild the index")
+	}
+}
@@ -0,0 +1,322 @@
+package semantic
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/internal/jsonfile"
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/elct9620/anytype-mcp-lite/pkg/mirror"
+)
+
+const (
+	// embedBatchSize is the number of chunks embedded per request.
+	embedBatchSize = 32
+	// DefaultLimit is the maximum search results when the limit is not given.
+	DefaultLimit = 10
+	// DefaultRefreshInterval is the interval to refresh the index in the background.
+	DefaultRefreshInterval = 5 * time.Minute
+)
+
+// Chunk is a part of the object markdown with its vector.
+type Chunk struct {
+	Text   string    `json:"text"`
+	Vector []float32 `json:"vector"`
+}
+
+type entry struct {
+	Name         string    `json:"name"`
+	LastModified time.Time `json:"last_modified_date"`
+	Chunks       []Chunk   `json:"chunks"`
+}
+
+type space struct {
+	Objects map[string]*entry `json:"objects"`
+}
+
+type snapshot struct {
+	Embedder    string            `json:"embedder"`
+	RefreshedAt time.Time         `json:"refreshed_at"`
+	Spaces      map[string]*space `json:"spaces"`
+}
+
+// Index is a vector index of the object chunks, it is saved to the file when the path is not empty.
+type Index struct {
+	file      *jsonfile.File
+	embedder  Embedder
+	mu        sync.RWMutex
+	data      snapshot
+	refreshMu sync.Mutex
+}
+
+// Open loads the index file, the index is empty when the file does not exist or is built by another embedder.
+func Open(path string, embedder Embedder) (*Index, error) {
+	index := &Index{file: jsonfile.New(path), embedder: embedder}
+	if err := index.load(); err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+func (ix *Index) load() error {
+	ix.data = snapshot{Embedder: ix.embedder.Name(), Spaces: make(map[string]*space)}
+
+	var data snapshot
+	if err := ix.file.Load(&data); err != nil {
+		return err
+	}
+
+	if data.Embedder == ix.embedder.Name() && data.Spaces != nil {
+		ix.data = data
+	}
+
+	return nil
+}
+
+// Reload loads the index file again when it is changed by another process, e.g. the sync command.
+func (ix *Index) Reload() error {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	changed, err := ix.file.Changed()
+	if err != nil || !changed {
+		return err
+	}
+
+	return ix.load()
+}
+
+// RefreshedAt returns the time of the last refresh, it is zero when the index is never built.
+func (ix *Index) RefreshedAt() time.Time {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	return ix.data.RefreshedAt
+}
+
+// RefreshReport summarizes the changes of a refresh.
+type RefreshReport struct {
+	Spaces  int
+	Objects int
+	Removed int
+}
+
+// Run syncs the mirror and refreshes the index from it every interval until the context is done, the first
+// run starts immediately when the index is older than the interval. Failed runs are reported to onError.
+func (ix *Index) Run(ctx context.Context, client *anytype.Anytype, store *mirror.Store, interval time.Duration, onError func(error)) {
+	delay := max(interval-time.Since(ix.RefreshedAt()), 0)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			if err := ix.sync(ctx, client, store); err != nil && ctx.Err() == nil {
+				onError(err)
+			}
+			timer.Reset(interval)
+		}
+	}
+}
+
+// sync picks up the mirror written by the sync command before syncing it, to fetch only the objects modified since.
+func (ix *Index) sync(ctx context.Context, client *anytype.Anytype, store *mirror.Store) error {
+	if err := store.Refresh(); err != nil {
+		return err
+	}
+
+	if _, err := mirror.Sync(ctx, client, store, false); err != nil {
+		return err
+	}
+
+	_, err := ix.Refresh(ctx, store, false)
+	return err
+}
+
+// Refresh embeds the mirrored objects modified since the last refresh and saves the index, the store is expected to be synced.
+// The objects and spaces no longer mirrored are dropped, and all objects are embedded again when full is true.
+func (ix *Index) Refresh(ctx context.Context, store *mirror.Store, full bool) (*RefreshReport, error) {
+	ix.refreshMu.Lock()
+	defer ix.refreshMu.Unlock()
+
+	report := &RefreshReport{}
+	refreshedAt := time.Now()
+	listed := make(map[string]bool)
+
+	for _, info := range store.Spaces() {
+		listed[info.ID] = true
+
+		count, removed, err := ix.refreshSpace(ctx, store.Objects(info.ID), info.ID, full)
+		if err != nil {
+			return nil, fmt.Errorf("refresh space %s: %w", info.Name, err)
+		}
+
+		report.Spaces++
+		report.Objects += count
+		report.Removed += removed
+	}
+
+	ix.mu.Lock()
+	for id, current := range ix.data.Spaces {
+		if !listed[id] {
+			report.Removed += len(current.Objects)
+			delete(ix.data.Spaces, id)
+		}
+	}
+	ix.data.RefreshedAt = refreshedAt
+	ix.mu.Unlock()
+
+	if err := ix.save(); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// refreshSpace drops the objects no longer mirrored, and embeds the objects with a last modified date
+// other than the indexed one. Objects without last modified date are always embedded.
+func (ix *Index) refreshSpace(ctx context.Context, objects []*mirror.Object, spaceId string, full bool) (int, int, error) {
+	indexed := make(map[string]time.Time)
+	ix.mu.RLock()
+	if current, ok := ix.data.Spaces[spaceId]; ok && !full {
+		for id, entry := range current.Objects {
+			indexed[id] = entry.LastModified
+		}
+	}
+	ix.mu.RUnlock()
+
+	listed := make(map[string]bool)
+	entries := make(map[string]*entry)
+	for _, object := range objects {
+		listed[object.ID] = true
+
+		modified, ok := indexed[object.ID]
+		if ok && !object.LastModified.IsZero() && object.LastModified.Equal(modified) {
+			continue
+		}
+
+		chunks, err := ix.embed(ctx, object.Object)
+		if err != nil {
+			return 0, 0, fmt.Errorf("embed object %s: %w", object.ID, err)
+		}
+
+		entries[object.ID] = &entry{Name: object.Name, LastModified: object.LastModified, Chunks: chunks}
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	current, ok := ix.data.Spaces[spaceId]
+	if !ok {
+		current = &space{Objects: make(map[string]*entry)}
+		ix.data.Spaces[spaceId] = current
+	}
+
+	removed := 0
+	for id := range current.Objects {
+		if !listed[id] {
+			delete(current.Objects, id)
+			removed++
+		}
+	}
+
+	for id, entry := range entries {
+		current.Objects[id] = entry
+	}
+
+	return len(entries), removed, nil
+}
+
+// embed splits the object markdown into chunks and embeds them with the object name for context.
+func (ix *Index) embed(ctx context.Context, object anytype.Object) ([]Chunk, error) {
+	texts := chunkMarkdown(object.Markdown, chunkSize)
+	if len(texts) == 0 {
+		texts = []string{object.Name}
+	}
+
+	chunks := make([]Chunk, 0, len(texts))
+	for start := 0; start < len(texts); start += embedBatchSize {
+		batch := texts[start:min(start+embedBatchSize, len(texts))]
+
+		inputs := make([]string, len(batch))
+		for i, text := range batch {
+			inputs[i] = object.Name + "\n\n" + text
+		}
+
+		vectors, err := ix.embedder.Embed(ctx, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, text := range batch {
+			chunks = append(chunks, Chunk{Text: text, Vector: vectors[i]})
+		}
+	}
+
+	return chunks, nil
+}
+
+func (ix *Index) save() error {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	return ix.file.Save(ix.data)
+}
+
+// Result is an object matched by the query with its most similar chunk.
+type Result struct {
+	SpaceId  string
+	ObjectId string
+	Name     string
+	Snippet  string
+	Score    float64
+}
+
+// Search returns the objects ranked by the similarity of their best matching chunk, the space id is optional.
+func (ix *Index) Search(ctx context.Context, query, spaceId string, limit int) ([]Result, error) {
+	vectors, err := ix.embedder.Embed(ctx, []string{query})
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	var results []Result
+	for id, current := range ix.data.Spaces {
+		if spaceId != "" && id != spaceId {
+			continue
+		}
+
+		for objectId, entry := range current.Objects {
+			best := Result{SpaceId: id, ObjectId: objectId, Name: entry.Name, Score: -1}
+			for _, chunk := range entry.Chunks {
+				if score := cosine(vectors[0], chunk.Vector); score > best.Score {
+					best.Score = score
+					best.Snippet = chunk.Text
+				}
+			}
+
+			if best.Score > 0 {
+				results = append(results, best)
+			}
+		}
+	}
+
+	slices.SortFunc(results, func(a, b Result) int {
+		return cmp.Or(cmp.Compare(b.Score, a.Score), cmp.Compare(a.ObjectId, b.ObjectId))
+	})
+
+	return results[:min(limit, len(results))], nil
+}
@@ -0,0 +1,229 @@
+package semantic
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/internal/anytypetest"
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/elct9620/anytype-mcp-lite/pkg/mirror"
+)
+
+// recordingEmbedder records the names of the embedded objects.
+type recordingEmbedder struct {
+	*HashEmbedder
+	mu    sync.Mutex
+	names []string
+}
+
+func (e *recordingEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	e.mu.Lock()
+	for _, text := range texts {
+		name, _, _ := strings.Cut(text, "\n\n")
+		e.names = append(e.names, name)
+	}
+	e.mu.Unlock()
+
+	return e.HashEmbedder.Embed(ctx, texts)
+}
+
+func (e *recordingEmbedder) takeNames() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	names := e.names
+	e.names = nil
+	slices.Sort(names)
+	return names
+}
+
+// newMirror returns the mirror store synced from the fake Anytype with a func to sync the later changes.
+func newMirror(t *testing.T, fake *anytypetest.Server) (*mirror.Store, func()) {
+	t.Helper()
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	store, err := mirror.Open(filepath.Join(t.TempDir(), "mirror.json"))
+	if err != nil {
+		t.Fatalf("failed to open mirror: %v", err)
+	}
+
+	resync := func() {
+		t.Helper()
+
+		if _, err := mirror.Sync(context.Background(), client, store, false); err != nil {
+			t.Fatalf("failed to sync mirror: %v", err)
+		}
+	}
+	resync()
+
+	return store, resync
+}
+
+func TestIndex_RefreshAndSearch(t *testing.T) {
+	base := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+
+	fake := &anytypetest.Server{}
+	fake.Add("obj1", "Roadmap", "# Goals\n\nPlanning the quarterly roadmap and milestones", base)
+	fake.Add("obj2", "Recipes", "Chocolate cake with strawberries", base.Add(time.Hour))
+
+	store, resync := newMirror(t, fake)
+	embedder := &recordingEmbedder{HashEmbedder: NewHashEmbedder(0)}
+	path := filepath.Join(t.TempDir(), "semantic.json")
+
+	index, err := Open(path, embedder)
+	if err != nil {
+		t.Fatalf("failed to open index: %v", err)
+	}
+
+	report, err := index.Refresh(context.Background(), store, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if report.Spaces != 1 || report.Objects != 2 {
+		t.Errorf("expected 2 objects in 1 space, got %+v", report)
+	}
+
+	results, err := index.Search(context.Background(), "quarter planning milestone", "", 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(results) != 1 || results[0].ObjectId != "obj1" || results[0].SpaceId != "space1" || results[0].Name != "Roadmap" {
+		t.Fatalf("expected Roadmap ranked first, got %+v", results)
+	}
+
+	if !strings.Contains(results[0].Snippet, "quarterly roadmap") {
+		t.Errorf("expected snippet of the matched chunk, got %q", results[0].Snippet)
+	}
+
+	if results, _ := index.Search(context.Background(), "roadmap", "space2", 0); len(results) != 0 {
+		t.Errorf("expected no results in other space, got %+v", results)
+	}
+
+	fake.Add("obj3", "Garden", "Plant tomatoes in spring", base.Add(2*time.Hour))
+	resync()
+	embedder.takeNames()
+
+	if _, err := index.Refresh(context.Background(), store, false); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if names := embedder.takeNames(); !slices.Equal(names, []string{"Garden"}) {
+		t.Errorf("expected only objects modified since last refresh embedded, got %v", names)
+	}
+
+	reopened, err := Open(path, NewHashEmbedder(0))
+	if err != nil {
+		t.Fatalf("failed to open index: %v", err)
+	}
+
+	if reopened.RefreshedAt().IsZero() {
+		t.Error("expected refresh time saved")
+	}
+
+	results, _ = reopened.Search(context.Background(), "tomato", "", 0)
+	if len(results) == 0 || results[0].ObjectId != "obj3" {
+		t.Errorf("expected Garden ranked first after reopen, got %+v", results)
+	}
+
+	rebuilt, err := Open(path, NewHashEmbedder(64))
+	if err != nil {
+		t.Fatalf("failed to open index: %v", err)
+	}
+
+	if !rebuilt.RefreshedAt().IsZero() {
+		t.Error("expected index built by another embedder to be discarded")
+	}
+}
+
+func TestIndex_RefreshPrune(t *testing.T) {
+	base := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+
+	fake := &anytypetest.Server{}
+	fake.Add("obj1", "Roadmap", "Planning the quarterly roadmap", base)
+	fake.Add("obj2", "Recipes", "Chocolate cake with strawberries", base.Add(time.Hour))
+	fake.Add("obj3", "Undated", "Notes without date", time.Time{})
+
+	store, resync := newMirror(t, fake)
+	embedder := &recordingEmbedder{HashEmbedder: NewHashEmbedder(0)}
+
+	index, err := Open("", embedder)
+	if err != nil {
+		t.Fatalf("failed to open index: %v", err)
+	}
+
+	if _, err := index.Refresh(context.Background(), store, false); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	fake.Remove("obj1")
+	resync()
+	embedder.takeNames()
+
+	report, err := index.Refresh(context.Background(), store, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if report.Objects != 1 || report.Removed != 1 {
+		t.Errorf("expected 1 object removed and the undated object embedded, got %+v", report)
+	}
+
+	if names := embedder.takeNames(); !slices.Equal(names, []string{"Undated"}) {
+		t.Errorf("expected only the undated object embedded again, got %v", names)
+	}
+
+	if results, _ := index.Search(context.Background(), "quarterly roadmap", "", 0); slices.ContainsFunc(results, func(r Result) bool { return r.ObjectId == "obj1" }) {
+		t.Errorf("expected deleted object not searchable, got %+v", results)
+	}
+
+	embedder.takeNames()
+	report, err = index.Refresh(context.Background(), store, true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if names := embedder.takeNames(); !slices.Equal(names, []string{"Recipes", "Undated"}) || report.Objects != 2 {
+		t.Errorf("expected full refresh to embed all objects again, got %v", names)
+	}
+}
+
+func TestIndex_Reload(t *testing.T) {
+	fake := &anytypetest.Server{}
+	fake.Add("obj1", "Roadmap", "Planning the quarterly roadmap", time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC))
+
+	store, _ := newMirror(t, fake)
+	path := filepath.Join(t.TempDir(), "semantic.json")
+
+	reader, err := Open(path, NewHashEmbedder(0))
+	if err != nil {
+		t.Fatalf("failed to open index: %v", err)
+	}
+
+	writer, err := Open(path, NewHashEmbedder(0))
+	if err != nil {
+		t.Fatalf("failed to open index: %v", err)
+	}
+
+	if _, err := writer.Refresh(context.Background(), store, false); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := reader.Reload(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if reader.RefreshedAt().IsZero() {
+		t.Error("expected index refreshed by another process to be reloaded")
+	}
+}
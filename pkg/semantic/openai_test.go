@@ -0,0 +1,73 @@
+package semantic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestOpenAIEmbedder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/embeddings" {
+			t.Errorf("expected path /v1/embeddings, got %s", r.URL.Path)
+		}
+
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			t.Errorf("expected Authorization header, got %s", r.Header.Get("Authorization"))
+		}
+
+		var body embeddingRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.Model != "nomic-embed-text" || len(body.Input) != 2 {
+			t.Errorf("unexpected request body %+v", body)
+		}
+
+		// the API may return the embeddings out of order
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"index":1,"embedding":[0,1]},{"index":0,"embedding":[1,0]}]}`))
+	}))
+	defer server.Close()
+
+	embedder := NewOpenAIEmbedder(server.URL+"/v1/", "nomic-embed-text", "test-key")
+	vectors, err := embedder.Embed(context.Background(), []string{"first", "second"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := [][]float32{{1, 0}, {0, 1}}
+	if !reflect.DeepEqual(vectors, expected) {
+		t.Errorf("expected vectors %v, got %v", expected, vectors)
+	}
+}
+
+func TestOpenAIEmbedder_Error(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"error status", http.StatusNotFound, `{"error":"model not found"}`},
+		{"missing embedding", http.StatusOK, `{"data":[{"index":0,"embedding":[1]}]}`},
+		{"unknown index", http.StatusOK, `{"data":[{"index":5,"embedding":[1]}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			embedder := NewOpenAIEmbedder(server.URL, "model", "")
+			if _, err := embedder.Embed(context.Background(), []string{"first", "second"}); err == nil {
+				t.Fatal("expected error, got nil")
+			}
+		})
+	}
+}
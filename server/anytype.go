@@ -1,11 +1,48 @@
 package server
 
-import "github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+import (
+	"sync"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/elct9620/anytype-mcp-lite/pkg/mirror"
+	"github.com/elct9620/anytype-mcp-lite/pkg/semantic"
+)
 
 type App struct {
-	anytype *anytype.Anytype
+	anytype     *anytype.Anytype
+	tokenBudget int
+	mirror      *mirror.Store
+
+	semantic *semantic.Index
+
+	membersMu sync.Mutex
+	members   map[string]memberCache
+}
+
+type AppOption func(*App)
+
+func New(client *anytype.Anytype, opts ...AppOption) *App {
+	app := &App{anytype: client}
+
+	for _, opt := range opts {
+		opt(app)
+	}
+
+	return app
+}
+
+// WithTokenBudget limits the estimated tokens of markdown returned by a single call, zero means unlimited.
+func WithTokenBudget(tokens int) AppOption {
+	return func(a *App) {
+		a.tokenBudget = tokens
+	}
 }
 
-func New(client *anytype.Anytype) *App {
-	return &App{anytype: client}
+// WithMirror serves search and get-object from the offline mirror when Anytype is not reachable,
+// and indexes the links of the mirrored objects to find backlinks.
+func WithMirror(store *mirror.Store) AppOption {
+	return func(a *App) {
+		store.IndexLinks(linkedObjectIds)
+		a.mirror = store
+	}
 }
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ArchiveObjectParams struct {
+	ObjectId string `json:"objectId" jsonschema:"the id of the object to archive"`
+	SpaceId  string `json:"spaceId" jsonschema:"the space id of the object to archive"`
+}
+
+func (a *App) ArchiveObject(ctx context.Context, req *mcp.CallToolRequest, params ArchiveObjectParams) (*mcp.CallToolResult, *WriteObjectResult, error) {
+	res, err := a.anytype.DeleteObject(ctx, anytype.DeleteObjectInput{
+		Params: anytype.DeleteObjectParams{
+			ObjectId: params.ObjectId,
+			SpaceId:  params.SpaceId,
+		},
+	})
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("object %s not found in space %s; try search to find the object id", params.ObjectId, params.SpaceId))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	return nil, &WriteObjectResult{
+		ObjectId: res.Object.ID,
+		SpaceId:  res.Object.SpaceId,
+		Name:     res.Object.Name,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestArchiveObject_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/v1/spaces/space1/objects/obj1" {
+			t.Errorf("expected path /v1/spaces/space1/objects/obj1, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&anytype.DeleteObjectOutput{
+			Object: anytype.Object{ID: "obj1", SpaceId: "space1", Name: "Old Note"},
+		})
+	}))
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	app := New(client)
+	req := &mcp.CallToolRequest{}
+
+	_, result, err := app.ArchiveObject(context.Background(), req, ArchiveObjectParams{
+		ObjectId: "obj1",
+		SpaceId:  "space1",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &WriteObjectResult{ObjectId: "obj1", SpaceId: "space1", Name: "Old Note"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected result %+v, got %+v", expected, result)
+	}
+}
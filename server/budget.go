@@ -0,0 +1,107 @@
+package server
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// charsPerToken is a rough estimate to convert characters into tokens without a tokenizer.
+const charsPerToken = 4
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor, use the nextCursor returned by previous call")
+	ErrStaleCursor   = errors.New("the object is modified since the cursor is returned, read it again without cursor")
+)
+
+func estimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + charsPerToken - 1) / charsPerToken
+}
+
+// effectiveBudget returns the smaller non-zero budget, zero means unlimited.
+func effectiveBudget(server, call int) int {
+	if server <= 0 || (call > 0 && call < server) {
+		return call
+	}
+
+	return server
+}
+
+// truncateMarkdown returns the markdown chunk starting at offset which fits the token budget
+// and the offset of the remaining content, the next offset is zero when the end is reached.
+// The chunk prefers to end at a line break to avoid splitting paragraphs.
+func truncateMarkdown(markdown string, offset, budget int) (string, int) {
+	rest := markdown[offset:]
+	if budget <= 0 || estimateTokens(rest) <= budget {
+		return rest, 0
+	}
+
+	end, chars := 0, 0
+	for end < len(rest) && chars < budget*charsPerToken {
+		_, size := utf8.DecodeRuneInString(rest[end:])
+		end += size
+		chars++
+	}
+
+	if idx := strings.LastIndexByte(rest[:end], '\n'); idx >= end/2 {
+		end = idx + 1
+	}
+
+	return rest[:end], offset + end
+}
+
+// encodeCursor encodes the offset with the last modified date of the object to detect changes between calls.
+func encodeCursor(offset int, modified time.Time) string {
+	var version int64
+	if !modified.IsZero() {
+		version = modified.Unix()
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte("md:" + strconv.FormatInt(version, 10) + ":" + strconv.Itoa(offset)))
+}
+
+// decodeCursor returns the offset of the cursor, the offset is moved back to the start of the character
+// when it is inside a multi-byte character. The cursor is stale when the object is modified after it is returned.
+func decodeCursor(cursor, markdown string, modified time.Time) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+
+	value, ok := strings.CutPrefix(string(data), "md:")
+	if !ok {
+		return 0, ErrInvalidCursor
+	}
+
+	rawVersion, rawOffset, ok := strings.Cut(value, ":")
+	if !ok {
+		return 0, ErrInvalidCursor
+	}
+
+	version, err := strconv.ParseInt(rawVersion, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+
+	offset, err := strconv.Atoi(rawOffset)
+	if err != nil || offset < 0 || offset > len(markdown) {
+		return 0, ErrInvalidCursor
+	}
+
+	if !modified.IsZero() && version != modified.Unix() {
+		return 0, ErrStaleCursor
+	}
+
+	for offset > 0 && offset < len(markdown) && !utf8.RuneStart(markdown[offset]) {
+		offset--
+	}
+
+	return offset, nil
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTruncateMarkdown(t *testing.T) {
+	tests := []struct {
+		name          string
+		markdown      string
+		offset        int
+		budget        int
+		expectedChunk string
+		expectedNext  int
+	}{
+		{"unlimited budget", "# Title\n\nBody", 0, 0, "# Title\n\nBody", 0},
+		{"fits in budget", "# Title\n\nBody", 0, 10, "# Title\n\nBody", 0},
+		{"cut at line break", "# Title\nfirst line\nsecond line", 0, 5, "# Title\nfirst line\n", 19},
+		{"cut without line break", "abcdefghijklmnop", 0, 2, "abcdefgh", 8},
+		{"continue from offset", "abcdefghijklmnop", 8, 2, "ijklmnop", 0},
+		{"multi-byte characters", "你好世界你好世界你好", 0, 1, "你好世界", 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chunk, next := truncateMarkdown(tt.markdown, tt.offset, tt.budget)
+			if chunk != tt.expectedChunk {
+				t.Errorf("expected chunk %q, got %q", tt.expectedChunk, chunk)
+			}
+
+			if next != tt.expectedNext {
+				t.Errorf("expected next offset %d, got %d", tt.expectedNext, next)
+			}
+		})
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	modified := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		cursor         string
+		markdown       string
+		modified       time.Time
+		expectedOffset int
+		expectedErr    error
+	}{
+		{"empty cursor", "", "content", modified, 0, nil},
+		{"encoded offset", encodeCursor(3, modified), "content", modified, 3, nil},
+		{"end of content", encodeCursor(7, modified), "content", modified, 7, nil},
+		{"without last modified date", encodeCursor(3, time.Time{}), "content", time.Time{}, 3, nil},
+		{"out of range", encodeCursor(8, modified), "content", modified, 0, ErrInvalidCursor},
+		{"inside multi-byte character", encodeCursor(4, modified), "你好", modified, 3, nil},
+		{"modified object", encodeCursor(3, modified), "content", modified.Add(time.Minute), 0, ErrStaleCursor},
+		{"malformed cursor", "not a cursor!", "content", modified, 0, ErrInvalidCursor},
+		{"cursor without version", base64.RawURLEncoding.EncodeToString([]byte("md:3")), "content", modified, 0, ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			offset, err := decodeCursor(tt.cursor, tt.markdown, tt.modified)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+
+			if offset != tt.expectedOffset {
+				t.Errorf("expected offset %d, got %d", tt.expectedOffset, offset)
+			}
+		})
+	}
+}
+
+func TestEffectiveBudget(t *testing.T) {
+	tests := []struct {
+		name     string
+		server   int
+		call     int
+		expected int
+	}{
+		{"both unlimited", 0, 0, 0},
+		{"server budget only", 100, 0, 100},
+		{"call budget only", 0, 50, 50},
+		{"call below server", 100, 50, 50},
+		{"call above server", 100, 500, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if result := effectiveBudget(tt.server, tt.call); result != tt.expected {
+				t.Errorf("expected %d, got %d", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type CreateObjectParams struct {
+	SpaceId    string         `json:"spaceId" jsonschema:"the space id to create the object in"`
+	Type       string         `json:"type" jsonschema:"the type key of the object, e.g. page or task"`
+	Name       string         `json:"name" jsonschema:"the name of the object"`
+	Markdown   string         `json:"markdown,omitempty" jsonschema:"the markdown body of the object"`
+	TemplateId string         `json:"templateId,omitempty" jsonschema:"the template id to apply, use list-templates to find the templates of the type"`
+	Properties map[string]any `json:"properties,omitempty" jsonschema:"the property name to value map, tags are set by name"`
+}
+
+type WriteObjectResult struct {
+	ObjectId string `json:"objectId" jsonschema:"the id of the object"`
+	SpaceId  string `json:"spaceId" jsonschema:"the space id of the object"`
+	Name     string `json:"name" jsonschema:"the name of the object"`
+}
+
+func (a *App) CreateObject(ctx context.Context, req *mcp.CallToolRequest, params CreateObjectParams) (*mcp.CallToolResult, *WriteObjectResult, error) {
+	props, err := a.propertyInputs(ctx, params.SpaceId, params.Properties)
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("space %s or type %q not found; use list-spaces and describe-space to find them", params.SpaceId, params.Type))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	res, err := a.anytype.CreateObject(ctx, anytype.CreateObjectInput{
+		Params: anytype.CreateObjectParams{
+			SpaceId: params.SpaceId,
+		},
+		Body: anytype.CreateObjectBody{
+			Name:       params.Name,
+			TypeKey:    params.Type,
+			TemplateId: params.TemplateId,
+			Body:       params.Markdown,
+			Properties: props,
+		},
+	})
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("space %s or type %q not found; use list-spaces and describe-space to find them", params.SpaceId, params.Type))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	return nil, &WriteObjectResult{
+		ObjectId: res.Object.ID,
+		SpaceId:  res.Object.SpaceId,
+		Name:     res.Object.Name,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestCreateObject_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v1/spaces/space1/properties":
+			json.NewEncoder(w).Encode(&anytype.ListPropertiesOutput{
+				Data: []anytype.PropertyDefinition{{ID: "p1", Key: "done", Name: "Done", Format: "checkbox"}},
+			})
+		case "/v1/spaces/space1/objects":
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST method, got %s", r.Method)
+			}
+
+			var body anytype.CreateObjectBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+
+			done := false
+			expected := anytype.CreateObjectBody{
+				Name:       "Meeting Notes",
+				TypeKey:    "page",
+				TemplateId: "tpl1",
+				Body:       "## Agenda",
+				Properties: []anytype.PropertyValue{{Key: "done", Checkbox: &done}},
+			}
+			if !reflect.DeepEqual(body, expected) {
+				t.Errorf("expected body %+v, got %+v", expected, body)
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(&anytype.CreateObjectOutput{
+				Object: anytype.Object{ID: "obj1", SpaceId: "space1", Name: body.Name},
+			})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	app := New(client)
+	req := &mcp.CallToolRequest{}
+
+	mcpResult, result, err := app.CreateObject(context.Background(), req, CreateObjectParams{
+		SpaceId:    "space1",
+		Type:       "page",
+		Name:       "Meeting Notes",
+		Markdown:   "## Agenda",
+		TemplateId: "tpl1",
+		Properties: map[string]any{"Done": false},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mcpResult != nil {
+		t.Fatal("expected nil MCP result for success")
+	}
+
+	expected := &WriteObjectResult{ObjectId: "obj1", SpaceId: "space1", Name: "Meeting Notes"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected result %+v, got %+v", expected, result)
+	}
+}
+
+func TestCreateObject_InvalidProperty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			t.Error("expected object not to be created")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&anytype.ListPropertiesOutput{})
+	}))
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	app := New(client)
+	req := &mcp.CallToolRequest{}
+
+	mcpResult, result, err := app.CreateObject(context.Background(), req, CreateObjectParams{
+		SpaceId:    "space1",
+		Type:       "page",
+		Name:       "Meeting Notes",
+		Properties: map[string]any{"Priority": "high"},
+	})
+
+	if err == nil {
+		t.Fatal("expected validation error, got nil")
+	}
+
+	if mcpResult == nil || !mcpResult.IsError {
+		t.Fatal("expected MCP error result")
+	}
+
+	if result != nil {
+		t.Error("expected nil result on error")
+	}
+}
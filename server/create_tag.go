@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type CreateTagParams struct {
+	SpaceId  string `json:"spaceId" jsonschema:"the space id of the property"`
+	Property string `json:"property" jsonschema:"the key or name of the select or multi_select property"`
+	Name     string `json:"name" jsonschema:"the name of the tag"`
+	Color    string `json:"color,omitempty" jsonschema:"the color of the tag: grey, yellow, orange, red, pink, purple, blue, ice, teal or lime"`
+}
+
+func (a *App) CreateTag(ctx context.Context, req *mcp.CallToolRequest, params CreateTagParams) (*mcp.CallToolResult, *TagItem, error) {
+	result, err := a.createTag(ctx, params)
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("space %s not found; use list-spaces to find the space id", params.SpaceId))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	return nil, result, nil
+}
+
+func (a *App) createTag(ctx context.Context, params CreateTagParams) (*TagItem, error) {
+	if err := validateColor(params.Color); err != nil {
+		return nil, err
+	}
+
+	def, err := a.selectProperty(ctx, params.SpaceId, params.Property)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := a.anytype.CreateTag(ctx, anytype.CreateTagInput{
+		Params: anytype.CreateTagParams{SpaceId: params.SpaceId, PropertyId: def.ID},
+		Body:   anytype.CreateTagBody{Name: params.Name, Color: params.Color},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TagItem{ID: res.Tag.ID, Name: res.Tag.Name, Color: res.Tag.Color}, nil
+}
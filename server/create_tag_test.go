@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestCreateTag(t *testing.T) {
+	app := newTagServer(t)
+
+	tests := []struct {
+		name     string
+		params   CreateTagParams
+		expected *TagItem
+		wantErr  bool
+	}{
+		{
+			name:     "tag with color",
+			params:   CreateTagParams{SpaceId: "space1", Property: "status", Name: "Blocked", Color: "red"},
+			expected: &TagItem{ID: "tag3", Name: "Blocked", Color: "red"},
+		},
+		{
+			name:     "tag without color",
+			params:   CreateTagParams{SpaceId: "space1", Property: "Status", Name: "Blocked"},
+			expected: &TagItem{ID: "tag3", Name: "Blocked"},
+		},
+		{
+			name:    "unknown color",
+			params:  CreateTagParams{SpaceId: "space1", Property: "status", Name: "Blocked", Color: "green"},
+			wantErr: true,
+		},
+		{
+			name:    "property without tags",
+			params:  CreateTagParams{SpaceId: "space1", Property: "description", Name: "Blocked"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, result, err := app.CreateTag(context.Background(), &mcp.CallToolRequest{}, tt.params)
+			if tt.wantErr {
+				if err == nil || res == nil || !res.IsError {
+					t.Fatalf("expected error result, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected result %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type DeleteTagParams struct {
+	SpaceId  string `json:"spaceId" jsonschema:"the space id of the property"`
+	Property string `json:"property" jsonschema:"the key or name of the select or multi_select property"`
+	Tag      string `json:"tag" jsonschema:"the name or id of the tag to delete"`
+}
+
+func (a *App) DeleteTag(ctx context.Context, req *mcp.CallToolRequest, params DeleteTagParams) (*mcp.CallToolResult, *TagItem, error) {
+	result, err := a.deleteTag(ctx, params)
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("tag %q not found; use list-tags to find the tags", params.Tag))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	return nil, result, nil
+}
+
+func (a *App) deleteTag(ctx context.Context, params DeleteTagParams) (*TagItem, error) {
+	def, err := a.selectProperty(ctx, params.SpaceId, params.Property)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := a.tagIds(ctx, params.SpaceId, def, []string{params.Tag})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := a.anytype.DeleteTag(ctx, anytype.DeleteTagInput{
+		Params: anytype.TagParams{SpaceId: params.SpaceId, PropertyId: def.ID, TagId: ids[0]},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TagItem{ID: res.Tag.ID, Name: res.Tag.Name, Color: res.Tag.Color}, nil
+}
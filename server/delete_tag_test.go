@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestDeleteTag(t *testing.T) {
+	app := newTagServer(t)
+
+	tests := []struct {
+		name     string
+		params   DeleteTagParams
+		expected *TagItem
+		wantErr  bool
+	}{
+		{
+			name:     "tag by name",
+			params:   DeleteTagParams{SpaceId: "space1", Property: "status", Tag: "Done"},
+			expected: &TagItem{ID: "tag2", Name: "Done", Color: "lime"},
+		},
+		{
+			name:    "unknown tag",
+			params:  DeleteTagParams{SpaceId: "space1", Property: "status", Tag: "Blocked"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, result, err := app.DeleteTag(context.Background(), &mcp.CallToolRequest{}, tt.params)
+			if tt.wantErr {
+				if err == nil || res == nil || !res.IsError {
+					t.Fatalf("expected error result, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected result %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}
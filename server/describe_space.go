@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type DescribeSpaceParams struct {
+	SpaceId string `json:"spaceId" jsonschema:"the id of the space to describe"`
+}
+
+type TypeSchema struct {
+	Key        string   `json:"key" jsonschema:"the key of the type, used to filter search"`
+	Name       string   `json:"name" jsonschema:"the name of the type"`
+	Properties []string `json:"properties,omitempty" jsonschema:"the property keys of the type"`
+}
+
+type PropertySchema struct {
+	Key    string   `json:"key" jsonschema:"the key of the property, used to sort search"`
+	Name   string   `json:"name" jsonschema:"the name of the property"`
+	Format string   `json:"format" jsonschema:"the format of the property"`
+	Tags   []string `json:"tags,omitempty" jsonschema:"the tag names of select and multi_select property"`
+}
+
+type DescribeSpaceResult struct {
+	Types      []TypeSchema     `json:"types" jsonschema:"the object types of the space"`
+	Properties []PropertySchema `json:"properties" jsonschema:"the properties of the space"`
+}
+
+func (a *App) DescribeSpace(ctx context.Context, req *mcp.CallToolRequest, params DescribeSpaceParams) (*mcp.CallToolResult, *DescribeSpaceResult, error) {
+	result, err := a.describeSpace(ctx, params.SpaceId)
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("space %s not found; use list-spaces to find the space id", params.SpaceId))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	return nil, result, nil
+}
+
+func (a *App) describeSpace(ctx context.Context, spaceId string) (*DescribeSpaceResult, error) {
+	types, err := a.spaceTypes(ctx, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := a.spaceProperties(ctx, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DescribeSpaceResult{
+		Types:      make([]TypeSchema, 0, len(types)),
+		Properties: make([]PropertySchema, 0, len(properties)),
+	}
+
+	for _, objectType := range types {
+		keys := make([]string, 0, len(objectType.Properties))
+		for _, prop := range objectType.Properties {
+			keys = append(keys, prop.Key)
+		}
+
+		result.Types = append(result.Types, TypeSchema{
+			Key:        objectType.Key,
+			Name:       objectType.Name,
+			Properties: keys,
+		})
+	}
+
+	for _, prop := range properties {
+		schema := PropertySchema{
+			Key:    prop.Key,
+			Name:   prop.Name,
+			Format: prop.Format,
+		}
+
+		if prop.Format == "select" || prop.Format == "multi_select" {
+			tags, err := a.propertyTags(ctx, spaceId, prop.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, tag := range tags {
+				schema.Tags = append(schema.Tags, tag.Name)
+			}
+		}
+
+		result.Properties = append(result.Properties, schema)
+	}
+
+	return result, nil
+}
+
+func (a *App) spaceTypes(ctx context.Context, spaceId string) ([]anytype.ObjectType, error) {
+	return anytype.Collect(a.anytype.ListTypesAll(ctx, anytype.ListTypesInput{
+		Params: anytype.ListTypesParams{SpaceId: spaceId},
+	}, 0))
+}
+
+// spaceType resolves the type referenced by its id, key or name.
+func (a *App) spaceType(ctx context.Context, spaceId, name string) (anytype.ObjectType, error) {
+	types, err := a.spaceTypes(ctx, spaceId)
+	if err != nil {
+		return anytype.ObjectType{}, err
+	}
+
+	idx := slices.IndexFunc(types, func(t anytype.ObjectType) bool {
+		return t.ID == name || t.Key == name || strings.EqualFold(t.Name, name)
+	})
+	if idx < 0 {
+		return anytype.ObjectType{}, fmt.Errorf("unknown type %q, use describe-space to list the types", name)
+	}
+
+	return types[idx], nil
+}
+
+func (a *App) spaceProperties(ctx context.Context, spaceId string) ([]anytype.PropertyDefinition, error) {
+	return anytype.Collect(a.anytype.ListPropertiesAll(ctx, anytype.ListPropertiesInput{
+		Params: anytype.ListPropertiesParams{SpaceId: spaceId},
+	}, 0))
+}
+
+func (a *App) propertyTags(ctx context.Context, spaceId, propertyId string) ([]anytype.Tag, error) {
+	return anytype.Collect(a.anytype.ListTagsAll(ctx, anytype.ListTagsInput{
+		Params: anytype.ListTagsParams{SpaceId: spaceId, PropertyId: propertyId},
+	}, 0))
+}
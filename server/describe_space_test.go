@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestDescribeSpace_Success(t *testing.T) {
+	responses := map[string]any{
+		"/v1/spaces/space1/types?offset=0": &anytype.ListTypesOutput{
+			Data: []anytype.ObjectType{
+				{
+					ID:   "type1",
+					Key:  "task",
+					Name: "Task",
+					Properties: []anytype.PropertyDefinition{
+						{ID: "prop1", Key: "status", Name: "Status", Format: "select"},
+						{ID: "prop2", Key: "done", Name: "Done", Format: "checkbox"},
+					},
+				},
+			},
+			Pagination: anytype.Pagination{Total: 2, Offset: 0, HasMore: true},
+		},
+		"/v1/spaces/space1/types?offset=1": &anytype.ListTypesOutput{
+			Data:       []anytype.ObjectType{{ID: "type2", Key: "page", Name: "Page"}},
+			Pagination: anytype.Pagination{Total: 2, Offset: 1},
+		},
+		"/v1/spaces/space1/properties?offset=0": &anytype.ListPropertiesOutput{
+			Data: []anytype.PropertyDefinition{
+				{ID: "prop1", Key: "status", Name: "Status", Format: "select"},
+				{ID: "prop2", Key: "done", Name: "Done", Format: "checkbox"},
+			},
+			Pagination: anytype.Pagination{Total: 2, Offset: 0},
+		},
+		"/v1/spaces/space1/properties/prop1/tags?offset=0": &anytype.ListTagsOutput{
+			Data: []anytype.Tag{
+				{ID: "tag1", Name: "To Do"},
+				{ID: "tag2", Name: "Done"},
+			},
+			Pagination: anytype.Pagination{Total: 2, Offset: 0},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.RequestURI()]
+		if !ok {
+			t.Errorf("unexpected request %s", r.URL.RequestURI())
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	app := New(client)
+	req := &mcp.CallToolRequest{}
+
+	mcpResult, result, err := app.DescribeSpace(context.Background(), req, DescribeSpaceParams{SpaceId: "space1"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mcpResult != nil {
+		t.Fatal("expected nil MCP result for success")
+	}
+
+	expected := &DescribeSpaceResult{
+		Types: []TypeSchema{
+			{Key: "task", Name: "Task", Properties: []string{"status", "done"}},
+			{Key: "page", Name: "Page", Properties: []string{}},
+		},
+		Properties: []PropertySchema{
+			{Key: "status", Name: "Status", Format: "select", Tags: []string{"To Do", "Done"}},
+			{Key: "done", Name: "Done", Format: "checkbox"},
+		},
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected result %+v, got %+v", expected, result)
+	}
+}
+
+func TestDescribeSpace_ErrorHandling(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&anytype.Error{Code: "not_found", Message: "Space not found", Object: "space", Status: 404})
+	}))
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	app := New(client)
+	req := &mcp.CallToolRequest{}
+
+	mcpResult, result, err := app.DescribeSpace(context.Background(), req, DescribeSpaceParams{SpaceId: "missing"})
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if mcpResult == nil || !mcpResult.IsError {
+		t.Fatal("expected MCP error result")
+	}
+
+	if result != nil {
+		t.Error("expected nil result on error")
+	}
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+)
+
+// toolError is a short actionable message for the model which keeps the original error for errors.Is and errors.As.
+type toolError struct {
+	message string
+	err     error
+}
+
+func (e *toolError) Error() string {
+	return e.message
+}
+
+func (e *toolError) Unwrap() error {
+	return e.err
+}
+
+// explainError converts the Anytype API errors into messages the model can act on, the notFound message is used for 404.
+// Other errors are returned as is.
+func explainError(err error, notFound string) error {
+	var message string
+	switch {
+	case errors.Is(err, anytype.ErrNotFound) && notFound != "":
+		message = notFound
+	case errors.Is(err, anytype.ErrUnauthorized):
+		message = "Anytype rejected the API key; ask the user to pair again with the auth command or update ANYTYPE_API_KEY"
+	case errors.Is(err, anytype.ErrRateLimited):
+		message = "Anytype API is rate limited; wait a moment before retrying"
+	case errors.Is(err, anytype.ErrServerUnavailable):
+		message = "Anytype is not reachable; ask the user to make sure the Anytype desktop app is running"
+	default:
+		return err
+	}
+
+	return &toolError{message: message, err: err}
+}
+
+// spaceNotFound is the not found message of the space, it is empty without space id to keep the original error.
+func spaceNotFound(spaceId string) string {
+	if spaceId == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("space %s not found; use list-spaces to find the space id", spaceId)
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+)
+
+func TestExplainError(t *testing.T) {
+	other := errors.New("unknown property")
+
+	tests := []struct {
+		name     string
+		err      error
+		notFound string
+		expected string
+		sentinel error
+	}{
+		{
+			name:     "not found",
+			err:      &anytype.Error{Status: http.StatusNotFound},
+			notFound: "object obj1 not found",
+			expected: "object obj1 not found",
+			sentinel: anytype.ErrNotFound,
+		},
+		{
+			name:     "not found without message",
+			err:      &anytype.Error{Message: "Not found", Code: "not_found", Object: "space", Status: http.StatusNotFound},
+			expected: "The object space returned an error: Not found (code: not_found, status: 404)",
+			sentinel: anytype.ErrNotFound,
+		},
+		{
+			name:     "rate limited",
+			err:      &anytype.Error{Status: http.StatusTooManyRequests},
+			expected: "Anytype API is rate limited; wait a moment before retrying",
+			sentinel: anytype.ErrRateLimited,
+		},
+		{
+			name:     "other error",
+			err:      other,
+			notFound: "object obj1 not found",
+			expected: "unknown property",
+			sentinel: other,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := explainError(tt.err, tt.notFound)
+			if err.Error() != tt.expected {
+				t.Errorf("expected error %q, got %q", tt.expected, err.Error())
+			}
+
+			if !errors.Is(err, tt.sentinel) {
+				t.Errorf("expected error to match %v", tt.sentinel)
+			}
+		})
+	}
+}
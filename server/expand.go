@@ -0,0 +1,239 @@
+package server
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+)
+
+const (
+	// maxExpandDepth is the maximum depth to follow linked objects.
+	maxExpandDepth = 3
+	// defaultExpandBudget is the estimated tokens of linked objects to return when no token budget is set.
+	defaultExpandBudget = 1000
+	// fetchConcurrency is the number of linked objects fetched at the same time.
+	fetchConcurrency = 4
+	// summaryTokens is the estimated tokens of the summary of a linked object.
+	summaryTokens = 40
+)
+
+// backlinksKey is the property which Anytype fills with the objects linking to the object.
+const backlinksKey = "backlinks"
+
+var anytypeLinkPattern = regexp.MustCompile(`anytype://[^\s()<>\[\]"'` + "`" + `]+`)
+
+type LinkedObject struct {
+	ID      string          `json:"id" jsonschema:"the id of the linked object"`
+	SpaceId string          `json:"spaceId" jsonschema:"the space id of the linked object"`
+	Name    string          `json:"name,omitempty" jsonschema:"the name of the linked object, empty when it cannot be read"`
+	Type    string          `json:"type,omitempty" jsonschema:"the type of the linked object"`
+	Summary string          `json:"summary,omitempty" jsonschema:"the beginning of the markdown of the linked object"`
+	Linked  []*LinkedObject `json:"linked,omitempty" jsonschema:"the objects linked by this object"`
+}
+
+type objectLink struct {
+	spaceId  string
+	objectId string
+	via      string
+}
+
+// parseObjectLink parses both anytype://object?objectId=...&spaceId=... deep links and anytype://{spaceId}/{objectId} resource URIs,
+// the space defaults to spaceId when the deep link has none.
+func parseObjectLink(uri, spaceId string) (objectLink, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "anytype" {
+		return objectLink{}, false
+	}
+
+	if u.Host == "object" {
+		query := u.Query()
+		if query.Get("objectId") == "" {
+			return objectLink{}, false
+		}
+
+		if query.Get("spaceId") != "" {
+			spaceId = query.Get("spaceId")
+		}
+
+		return objectLink{spaceId: spaceId, objectId: query.Get("objectId")}, true
+	}
+
+	linkSpaceId, objectId, ok := parseObjectURI(uri)
+	return objectLink{spaceId: linkSpaceId, objectId: objectId}, ok
+}
+
+// objectLinks returns the objects linked by the properties and the anytype:// links in the markdown without duplicates,
+// the members and the backlinks property are skipped.
+func objectLinks(object *anytype.Object) []objectLink {
+	var links []objectLink
+	seen := map[string]bool{object.ID: true}
+
+	add := func(link objectLink) {
+		if seen[link.objectId] || isMemberId(link.objectId) {
+			return
+		}
+
+		seen[link.objectId] = true
+		links = append(links, link)
+	}
+
+	for _, prop := range object.Properties {
+		if prop.Format != "objects" || prop.Key == backlinksKey {
+			continue
+		}
+
+		for _, id := range prop.Objects {
+			add(objectLink{spaceId: object.SpaceId, objectId: id, via: prop.Name})
+		}
+	}
+
+	for _, uri := range anytypeLinkPattern.FindAllString(object.Markdown, -1) {
+		// Bare links in text may end with punctuation of the sentence.
+		uri = strings.TrimRight(uri, ".,;:!?")
+		if link, ok := parseObjectLink(uri, object.SpaceId); ok {
+			link.via = "markdown"
+			add(link)
+		}
+	}
+
+	return links
+}
+
+// linkedObjectIds returns the ids of the objects linked by the object for the backlink index of the mirror.
+func linkedObjectIds(object *anytype.Object) []string {
+	links := objectLinks(object)
+
+	ids := make([]string, len(links))
+	for i, link := range links {
+		ids[i] = link.objectId
+	}
+
+	return ids
+}
+
+// expandLinks follows the linked objects level by level up to the depth, each object is visited once to break cycles
+// and the expansion stops when the estimated tokens of the linked objects reach the budget, which is reported as truncated.
+func (a *App) expandLinks(ctx context.Context, object *anytype.Object, depth, budget int) ([]*LinkedObject, bool) {
+	type pending struct {
+		link   objectLink
+		parent *LinkedObject
+	}
+
+	visited := map[string]bool{object.ID: true}
+	var level []pending
+	for _, link := range objectLinks(object) {
+		visited[link.objectId] = true
+		level = append(level, pending{link: link})
+	}
+
+	var roots []*LinkedObject
+	spent := 0
+	for d := 1; d <= depth && len(level) > 0; d++ {
+		// Only fetch the links whose ids alone fit the remaining budget.
+		capped, cost := 0, spent
+		for ; capped < len(level); capped++ {
+			cost += estimateTokens(level[capped].link.objectId + level[capped].link.spaceId)
+			if cost > budget {
+				break
+			}
+		}
+
+		truncated := capped < len(level)
+		level = level[:capped]
+
+		links := make([]objectLink, len(level))
+		for i, p := range level {
+			links[i] = p.link
+		}
+		objects := a.fetchLinked(ctx, links)
+
+		var next []pending
+		for i, p := range level {
+			node := &LinkedObject{ID: p.link.objectId, SpaceId: p.link.spaceId}
+			if linked := objects[i]; linked != nil {
+				node.Name = linked.Name
+				node.Type = linked.Type.Name
+				node.Summary = summarize(linked.Markdown)
+			}
+
+			spent += estimateTokens(node.ID + node.SpaceId + node.Name + node.Type + node.Summary)
+			if spent > budget {
+				return roots, true
+			}
+
+			if p.parent == nil {
+				roots = append(roots, node)
+			} else {
+				p.parent.Linked = append(p.parent.Linked, node)
+			}
+
+			if objects[i] == nil || d == depth {
+				continue
+			}
+
+			for _, link := range objectLinks(objects[i]) {
+				if visited[link.objectId] {
+					continue
+				}
+
+				visited[link.objectId] = true
+				next = append(next, pending{link: link, parent: node})
+			}
+		}
+
+		if truncated {
+			return roots, true
+		}
+
+		level = next
+	}
+
+	return roots, false
+}
+
+// fetchLinked fetches the linked objects concurrently, the objects which cannot be read are nil.
+func (a *App) fetchLinked(ctx context.Context, links []objectLink) []*anytype.Object {
+	objects := make([]*anytype.Object, len(links))
+	sem := make(chan struct{}, fetchConcurrency)
+
+	var wg sync.WaitGroup
+	for i, link := range links {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			object, _, err := a.fetchObject(ctx, link.spaceId, link.objectId)
+			if err == nil {
+				objects[i] = object
+			}
+		}()
+	}
+	wg.Wait()
+
+	return objects
+}
+
+// summarize returns the first paragraph of the markdown which is not a heading, truncated to the summary tokens.
+func summarize(markdown string) string {
+	for line := range strings.SplitSeq(markdown, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		summary, next := truncateMarkdown(line, 0, summaryTokens)
+		if next > 0 {
+			summary += "..."
+		}
+
+		return summary
+	}
+
+	return ""
+}
@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestParseObjectLink(t *testing.T) {
+	tests := []struct {
+		uri      string
+		expected objectLink
+		ok       bool
+	}{
+		{"anytype://object?objectId=obj1&spaceId=space2", objectLink{spaceId: "space2", objectId: "obj1"}, true},
+		{"anytype://object?objectId=obj1", objectLink{spaceId: "space1", objectId: "obj1"}, true},
+		{"anytype://space2/obj1", objectLink{spaceId: "space2", objectId: "obj1"}, true},
+		{"anytype://object?spaceId=space2", objectLink{}, false},
+		{"https://example.com/obj1", objectLink{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			t.Parallel()
+
+			link, ok := parseObjectLink(tt.uri, "space1")
+			if link != tt.expected || ok != tt.ok {
+				t.Errorf("expected (%+v, %v), got (%+v, %v)", tt.expected, tt.ok, link, ok)
+			}
+		})
+	}
+}
+
+func TestObjectLinks(t *testing.T) {
+	object := &anytype.Object{
+		ID:       "obj1",
+		SpaceId:  "space1",
+		Markdown: "See [Design](anytype://object?objectId=obj3&spaceId=space1) and anytype://space1/obj2, back to [self](anytype://space1/obj1).",
+		Properties: []anytype.Property{
+			{Key: "links", Name: "Links", Format: "objects", Objects: []string{"obj2", "_participant_space1_alice"}},
+			{Key: "backlinks", Name: "Backlinks", Format: "objects", Objects: []string{"obj4"}},
+			{Key: "attachments", Format: "files", Files: []string{"file1"}},
+		},
+	}
+
+	expected := []objectLink{
+		{spaceId: "space1", objectId: "obj2", via: "Links"},
+		{spaceId: "space1", objectId: "obj3", via: "markdown"},
+	}
+	if links := objectLinks(object); !reflect.DeepEqual(links, expected) {
+		t.Errorf("expected links %+v, got %+v", expected, links)
+	}
+}
+
+func TestGetObject_Expand(t *testing.T) {
+	objects := map[string]anytype.Object{
+		"obj1": {
+			ID: "obj1", SpaceId: "space1", Name: "Roadmap", Markdown: "Plan with [Design](anytype://object?objectId=obj3&spaceId=space1)",
+			Properties: []anytype.Property{{Key: "links", Name: "Links", Format: "objects", Objects: []string{"obj2"}}},
+		},
+		"obj2": {
+			ID: "obj2", SpaceId: "space1", Name: "Goals", Type: anytype.ObjectType{Name: "Page"}, Markdown: "# Goals\n\nShip offline mode",
+			Properties: []anytype.Property{{Key: "links", Name: "Links", Format: "objects", Objects: []string{"obj1", "obj4"}}},
+		},
+		"obj3": {
+			ID: "obj3", SpaceId: "space1", Name: "Design", Type: anytype.ObjectType{Name: "Note"}, Markdown: "Use a local index",
+			Properties: []anytype.Property{{Key: "backlinks", Name: "Backlinks", Format: "objects", Objects: []string{"obj5"}}},
+		},
+		"obj4": {ID: "obj4", SpaceId: "space1", Name: "Metrics", Type: anytype.ObjectType{Name: "Page"}},
+		"obj5": {ID: "obj5", SpaceId: "space1", Name: "Archive", Type: anytype.ObjectType{Name: "Page"}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[len("/v1/spaces/space1/objects/"):]
+		object, ok := objects[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&anytype.Error{Code: "not_found", Message: "Object not found", Status: 404})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&anytype.GetObjectOutput{Object: object})
+	}))
+	t.Cleanup(server.Close)
+
+	app := New(anytype.New("test-api-key", anytype.WithApiServer(server.URL)))
+
+	goals := func(linked ...*LinkedObject) *LinkedObject {
+		return &LinkedObject{ID: "obj2", SpaceId: "space1", Name: "Goals", Type: "Page", Summary: "Ship offline mode", Linked: linked}
+	}
+	design := &LinkedObject{ID: "obj3", SpaceId: "space1", Name: "Design", Type: "Note", Summary: "Use a local index"}
+	metrics := &LinkedObject{ID: "obj4", SpaceId: "space1", Name: "Metrics", Type: "Page"}
+
+	// the markdown of obj1 costs 17 tokens of the budget before the linked objects
+	tests := []struct {
+		name      string
+		params    GetObjectParams
+		expected  []*LinkedObject
+		truncated bool
+	}{
+		{
+			name:   "without expand",
+			params: GetObjectParams{ObjectId: "obj1", SpaceId: "space1"},
+		},
+		{
+			name:     "one level",
+			params:   GetObjectParams{ObjectId: "obj1", SpaceId: "space1", Expand: 1},
+			expected: []*LinkedObject{goals(), design},
+		},
+		{
+			name:     "two levels skip visited objects and backlinks",
+			params:   GetObjectParams{ObjectId: "obj1", SpaceId: "space1", Expand: 2},
+			expected: []*LinkedObject{goals(metrics), design},
+		},
+		{
+			name:      "stop at budget",
+			params:    GetObjectParams{ObjectId: "obj1", SpaceId: "space1", Expand: 2, Budget: 17 + 15},
+			expected:  []*LinkedObject{goals()},
+			truncated: true,
+		},
+		{
+			name:      "budget below the ids of the level",
+			params:    GetObjectParams{ObjectId: "obj1", SpaceId: "space1", Expand: 2, Budget: 17 + 4},
+			truncated: true,
+		},
+		{
+			name:      "no budget left by the markdown",
+			params:    GetObjectParams{ObjectId: "obj1", SpaceId: "space1", Expand: 2, Budget: 10},
+			truncated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, result, err := app.GetObject(context.Background(), &mcp.CallToolRequest{}, tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(result.Linked, tt.expected) {
+				got, _ := json.Marshal(result.Linked)
+				want, _ := json.Marshal(tt.expected)
+				t.Errorf("expected linked %s, got %s", want, got)
+			}
+
+			if result.LinkedTruncated != tt.truncated {
+				t.Errorf("expected truncated %v, got %v", tt.truncated, result.LinkedTruncated)
+			}
+		})
+	}
+}
+
+func TestGetObject_ExpandFetchCap(t *testing.T) {
+	var links []string
+	for i := range 20 {
+		links = append(links, fmt.Sprintf("anytype://space1/obj%d", i+2))
+	}
+
+	var fetched atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetched.Add(1)
+
+		id := r.URL.Path[len("/v1/spaces/space1/objects/"):]
+		object := anytype.Object{ID: id, SpaceId: "space1", Name: id}
+		if id == "obj1" {
+			object.Markdown = strings.Join(links, "\n")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&anytype.GetObjectOutput{Object: object})
+	}))
+	t.Cleanup(server.Close)
+
+	app := New(anytype.New("test-api-key", anytype.WithApiServer(server.URL)))
+
+	// each link costs 3 tokens by its ids, so only 3 of them fit the 10 tokens left by the markdown
+	budget := estimateTokens(strings.Join(links, "\n")) + 10
+	_, result, err := app.GetObject(context.Background(), &mcp.CallToolRequest{}, GetObjectParams{ObjectId: "obj1", SpaceId: "space1", Expand: 1, Budget: budget})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if count := fetched.Load(); count != 4 {
+		t.Errorf("expected the object and 3 linked objects fetched, got %d requests", count)
+	}
+
+	if !result.LinkedTruncated {
+		t.Error("expected linked objects truncated")
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected string
+	}{
+		{"skip headings", "# Title\n\nFirst paragraph\n\nSecond", "First paragraph"},
+		{"empty markdown", "", ""},
+		{"long paragraph", "word " + strings.Repeat("x", 200), "word " + strings.Repeat("x", 155) + "..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if result := summarize(tt.markdown); result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// defaultLinksLimit is the number of outgoing links and backlinks returned per call when no limit is given.
+const defaultLinksLimit = 20
+
+type GetLinksParams struct {
+	ObjectId string `json:"objectId" jsonschema:"the id of the object"`
+	SpaceId  string `json:"spaceId" jsonschema:"the space id of the object"`
+	Limit    int    `json:"limit,omitempty" jsonschema:"the maximum number of outgoing links and of backlinks, defaults to 20"`
+	Cursor   string `json:"cursor,omitempty" jsonschema:"the nextCursor returned by previous call to read more links"`
+}
+
+type LinkItem struct {
+	ID      string `json:"id" jsonschema:"the id of the linked object"`
+	SpaceId string `json:"spaceId" jsonschema:"the space id of the linked object"`
+	Name    string `json:"name,omitempty" jsonschema:"the name of the linked object, empty when it cannot be read"`
+	Type    string `json:"type,omitempty" jsonschema:"the type of the linked object"`
+	Via     string `json:"via,omitempty" jsonschema:"the property name or markdown which holds the outgoing link"`
+}
+
+type GetLinksResult struct {
+	ObjectId   string     `json:"objectId" jsonschema:"the id of the object"`
+	SpaceId    string     `json:"spaceId" jsonschema:"the space id of the object"`
+	Outgoing   []LinkItem `json:"outgoing" jsonschema:"the objects linked from the object"`
+	Backlinks  []LinkItem `json:"backlinks" jsonschema:"the objects linking to the object"`
+	NextCursor string     `json:"nextCursor,omitempty" jsonschema:"the cursor to read more links when there are more than the limit"`
+	Stale      string     `json:"stale,omitempty" jsonschema:"set when the object is from the offline mirror"`
+}
+
+func (a *App) GetLinks(ctx context.Context, req *mcp.CallToolRequest, params GetLinksParams) (*mcp.CallToolResult, *GetLinksResult, error) {
+	object, stale, err := a.fetchObject(ctx, params.SpaceId, params.ObjectId)
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("object %s not found in space %s; try search to find the object id", params.ObjectId, params.SpaceId))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	outgoingOffset, backlinksOffset, err := decodeLinksCursor(params.Cursor)
+	if err != nil {
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultLinksLimit
+	}
+
+	outgoing := objectLinks(object)
+	backlinks := a.backlinks(object)
+
+	outgoingPage, outgoingNext := pageLinks(outgoing, outgoingOffset, limit)
+	backlinksPage, backlinksNext := pageLinks(backlinks, backlinksOffset, limit)
+
+	result := &GetLinksResult{
+		ObjectId:  object.ID,
+		SpaceId:   object.SpaceId,
+		Outgoing:  a.linkItems(ctx, outgoingPage),
+		Backlinks: a.linkItems(ctx, backlinksPage),
+		Stale:     stale,
+	}
+
+	if outgoingNext < len(outgoing) || backlinksNext < len(backlinks) {
+		result.NextCursor = encodeLinksCursor(outgoingNext, backlinksNext)
+	}
+
+	return nil, result, nil
+}
+
+// pageLinks returns the links from the offset up to the limit and the offset of the next page.
+func pageLinks(links []objectLink, offset, limit int) ([]objectLink, int) {
+	start := min(offset, len(links))
+	end := min(start+limit, len(links))
+
+	return links[start:end], end
+}
+
+// encodeLinksCursor encodes the offsets of the outgoing links and the backlinks.
+func encodeLinksCursor(outgoing, backlinks int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("links:" + strconv.Itoa(outgoing) + ":" + strconv.Itoa(backlinks)))
+}
+
+// decodeLinksCursor returns the offsets of the outgoing links and the backlinks, both are zero without cursor.
+func decodeLinksCursor(cursor string) (int, int, error) {
+	if cursor == "" {
+		return 0, 0, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, 0, ErrInvalidCursor
+	}
+
+	value, ok := strings.CutPrefix(string(data), "links:")
+	if !ok {
+		return 0, 0, ErrInvalidCursor
+	}
+
+	rawOutgoing, rawBacklinks, ok := strings.Cut(value, ":")
+	if !ok {
+		return 0, 0, ErrInvalidCursor
+	}
+
+	outgoing, err := strconv.Atoi(rawOutgoing)
+	if err != nil || outgoing < 0 {
+		return 0, 0, ErrInvalidCursor
+	}
+
+	backlinks, err := strconv.Atoi(rawBacklinks)
+	if err != nil || backlinks < 0 {
+		return 0, 0, ErrInvalidCursor
+	}
+
+	return outgoing, backlinks, nil
+}
+
+// backlinks reads the backlinks property, or looks up the reverse link index of the mirror when the property is missing.
+func (a *App) backlinks(object *anytype.Object) []objectLink {
+	links := []objectLink{}
+
+	idx := slices.IndexFunc(object.Properties, func(prop anytype.Property) bool { return prop.Key == backlinksKey })
+	if idx >= 0 {
+		for _, id := range object.Properties[idx].Objects {
+			links = append(links, objectLink{spaceId: object.SpaceId, objectId: id})
+		}
+
+		return links
+	}
+
+	if a.mirror == nil {
+		return links
+	}
+
+	for _, id := range a.mirror.Backlinks(object.SpaceId, object.ID) {
+		links = append(links, objectLink{spaceId: object.SpaceId, objectId: id})
+	}
+
+	return links
+}
+
+// linkItems resolves the names and types of the linked objects.
+func (a *App) linkItems(ctx context.Context, links []objectLink) []LinkItem {
+	objects := a.fetchLinked(ctx, links)
+
+	items := make([]LinkItem, len(links))
+	for i, link := range links {
+		items[i] = LinkItem{ID: link.objectId, SpaceId: link.spaceId, Via: link.via}
+		if objects[i] != nil {
+			items[i].Name = objects[i].Name
+			items[i].Type = objects[i].Type.Name
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func newLinksApp(t *testing.T) *App {
+	t.Helper()
+
+	objects := map[string]anytype.Object{
+		"obj1": {
+			ID: "obj1", SpaceId: "space1", Name: "Roadmap", Markdown: "See [Design](anytype://object?objectId=obj3&spaceId=space1)",
+			Properties: []anytype.Property{
+				{Key: "links", Name: "Links", Format: "objects", Objects: []string{"obj2"}},
+				{Key: "backlinks", Name: "Backlinks", Format: "objects", Objects: []string{"obj4", "missing"}},
+			},
+		},
+		"obj2": {ID: "obj2", SpaceId: "space1", Name: "Goals", Type: anytype.ObjectType{Name: "Page"}},
+		"obj3": {ID: "obj3", SpaceId: "space1", Name: "Design", Type: anytype.ObjectType{Name: "Note"}},
+		"obj4": {ID: "obj4", SpaceId: "space1", Name: "Weekly Sync", Type: anytype.ObjectType{Name: "Meeting"}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		object, ok := objects[r.URL.Path[len("/v1/spaces/space1/objects/"):]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&anytype.Error{Code: "not_found", Message: "Object not found", Status: 404})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&anytype.GetObjectOutput{Object: object})
+	}))
+	t.Cleanup(server.Close)
+
+	return New(anytype.New("test-api-key", anytype.WithApiServer(server.URL)))
+}
+
+func TestGetLinks(t *testing.T) {
+	app := newLinksApp(t)
+
+	_, result, err := app.GetLinks(context.Background(), &mcp.CallToolRequest{}, GetLinksParams{ObjectId: "obj1", SpaceId: "space1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &GetLinksResult{
+		ObjectId: "obj1",
+		SpaceId:  "space1",
+		Outgoing: []LinkItem{
+			{ID: "obj2", SpaceId: "space1", Name: "Goals", Type: "Page", Via: "Links"},
+			{ID: "obj3", SpaceId: "space1", Name: "Design", Type: "Note", Via: "markdown"},
+		},
+		Backlinks: []LinkItem{
+			{ID: "obj4", SpaceId: "space1", Name: "Weekly Sync", Type: "Meeting"},
+			{ID: "missing", SpaceId: "space1"},
+		},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected result %+v, got %+v", expected, result)
+	}
+
+	_, _, err = app.GetLinks(context.Background(), &mcp.CallToolRequest{}, GetLinksParams{ObjectId: "missing", SpaceId: "space1"})
+	if err == nil {
+		t.Error("expected error for missing object, got nil")
+	}
+}
+
+func TestGetLinks_Pagination(t *testing.T) {
+	app := newLinksApp(t)
+
+	params := GetLinksParams{ObjectId: "obj1", SpaceId: "space1", Limit: 1}
+	_, first, err := app.GetLinks(context.Background(), &mcp.CallToolRequest{}, params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(first.Outgoing) != 1 || first.Outgoing[0].ID != "obj2" || len(first.Backlinks) != 1 || first.Backlinks[0].ID != "obj4" {
+		t.Errorf("expected the first link of each list, got %+v", first)
+	}
+
+	if first.NextCursor == "" {
+		t.Fatal("expected next cursor when more links exist")
+	}
+
+	params.Cursor = first.NextCursor
+	_, second, err := app.GetLinks(context.Background(), &mcp.CallToolRequest{}, params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(second.Outgoing) != 1 || second.Outgoing[0].ID != "obj3" || len(second.Backlinks) != 1 || second.Backlinks[0].ID != "missing" {
+		t.Errorf("expected the second link of each list, got %+v", second)
+	}
+
+	if second.NextCursor != "" {
+		t.Errorf("expected no next cursor on the last page, got %q", second.NextCursor)
+	}
+
+	params.Cursor = "invalid"
+	if _, _, err := app.GetLinks(context.Background(), &mcp.CallToolRequest{}, params); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("expected invalid cursor error, got %v", err)
+	}
+}
+
+func TestGetLinks_MirrorBacklinks(t *testing.T) {
+	app := newOfflineApp(t, true)
+
+	_, result, err := app.GetLinks(context.Background(), &mcp.CallToolRequest{}, GetLinksParams{ObjectId: "obj2", SpaceId: "space1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &GetLinksResult{
+		ObjectId:  "obj2",
+		SpaceId:   "space1",
+		Outgoing:  []LinkItem{},
+		Backlinks: []LinkItem{{ID: "obj1", SpaceId: "space1", Name: "Roadmap", Type: "Page"}},
+		Stale:     testStaleness,
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected result %+v, got %+v", expected, result)
+	}
+}
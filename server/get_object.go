@@ -2,31 +2,37 @@ package server
 
 import (
 	"context"
+	"fmt"
 
-	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 type GetObjectParams struct {
 	ObjectId string `json:"objectId" jsonschema:"the id of the object to get"`
 	SpaceId  string `json:"spaceId,omitempty" jsonschema:"the space id of the object to get"`
+	Cursor   string `json:"cursor,omitempty" jsonschema:"the nextCursor of previous call to continue reading the markdown"`
+	Budget   int    `json:"budget,omitempty" jsonschema:"the maximum estimated tokens of markdown and linked objects to return"`
+	Outline  bool   `json:"outline,omitempty" jsonschema:"return the heading outline with section ids and sizes instead of markdown"`
+	Section  string `json:"section,omitempty" jsonschema:"the section id or heading path separated by /, escape / in a heading as \\/, to return only the markdown under the heading"`
+	Expand   int    `json:"expand,omitempty" jsonschema:"the depth up to 3 to follow linked objects and return their name, type and summary"`
 }
 
 type GetObjectResult struct {
-	ObjectId   string     `json:"objectId" jsonschema:"the id of the object"`
-	SpaceId    string     `json:"spaceId,omitempty" jsonschema:"the space id of the object"`
-	Markdown   string     `json:"markdown" jsonschema:"the markdown content of the object"`
-	Properties []Property `json:"properties" jsonschema:"the properties of the object"`
+	ObjectId        string          `json:"objectId" jsonschema:"the id of the object"`
+	SpaceId         string          `json:"spaceId,omitempty" jsonschema:"the space id of the object"`
+	Markdown        string          `json:"markdown" jsonschema:"the markdown content of the object"`
+	Properties      []Property      `json:"properties" jsonschema:"the properties of the object, only returned without cursor and outline"`
+	NextCursor      string          `json:"nextCursor,omitempty" jsonschema:"the cursor to read the remaining markdown when it is truncated"`
+	Outline         []OutlineItem   `json:"outline,omitempty" jsonschema:"the heading outline of the markdown"`
+	Linked          []*LinkedObject `json:"linked,omitempty" jsonschema:"the tree of linked objects when expand is set"`
+	LinkedTruncated bool            `json:"linkedTruncated,omitempty" jsonschema:"set when the tree of linked objects is cut by the token budget"`
+	Stale           string          `json:"stale,omitempty" jsonschema:"set when the object is from the offline mirror"`
 }
 
 func (a *App) GetObject(ctx context.Context, req *mcp.CallToolRequest, params GetObjectParams) (*mcp.CallToolResult, *GetObjectResult, error) {
-	res, err := a.anytype.GetObject(ctx, anytype.GetObjectInput{
-		Params: anytype.GetObjectParams{
-			ObjectId: params.ObjectId,
-			SpaceId:  params.SpaceId,
-		},
-	})
+	object, stale, err := a.fetchObject(ctx, params.SpaceId, params.ObjectId)
 	if err != nil {
+		err = explainError(err, fmt.Sprintf("object %s not found in space %s; try search to find the object id", params.ObjectId, params.SpaceId))
 		return &mcp.CallToolResult{
 			IsError: true,
 			Content: []mcp.Content{
@@ -35,29 +41,83 @@ func (a *App) GetObject(ctx context.Context, req *mcp.CallToolRequest, params Ge
 		}, nil, err
 	}
 
-	props := make([]Property, 0)
-	for _, prop := range res.Object.Properties {
-		if prop.Format != "text" && prop.Format != "date" {
-			continue
+	markdown := object.Markdown
+	sections := parseSections(markdown)
+	if params.Section != "" {
+		section := findSection(sections, params.Section)
+		if section == nil {
+			err := fmt.Errorf("section %q not found, use outline to list the sections", params.Section)
+			return &mcp.CallToolResult{
+				IsError: true,
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: err.Error()},
+				},
+			}, nil, err
 		}
 
-		p := Property{
-			Name:   prop.Name,
-			Format: prop.Format,
-			Value:  prop.Text,
-		}
-		if prop.Format == "date" {
-			p.Value = prop.Date
+		sections = []*Section{section}
+		markdown = markdown[section.Start:section.End]
+	}
+
+	offset, err := decodeCursor(params.Cursor, markdown, object.LastModified())
+	if err != nil {
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	result := &GetObjectResult{
+		ObjectId:   object.ID,
+		SpaceId:    object.SpaceId,
+		Properties: make([]Property, 0),
+		Stale:      stale,
+	}
+
+	if params.Outline {
+		result.Outline = outline(object.Markdown, sections)
+		return nil, result, nil
+	}
+
+	if params.Cursor == "" {
+		names := a.linkedNames(ctx, object.SpaceId, object.Properties, stale != "")
+
+		for _, prop := range object.Properties {
+			value, ok := propertyValue(prop, names)
+			if !ok {
+				continue
+			}
+
+			result.Properties = append(result.Properties, Property{
+				Name:   prop.Name,
+				Format: prop.Format,
+				Value:  value,
+			})
 		}
+	}
 
-		props = append(props, p)
+	budget := effectiveBudget(a.tokenBudget, params.Budget)
+	chunk, next := truncateMarkdown(markdown, offset, budget)
+	result.Markdown = chunk
+	if next > 0 {
+		result.NextCursor = encodeCursor(next, object.LastModified())
+	}
 
+	if params.Expand > 0 && params.Cursor == "" {
+		// The linked objects share the budget with the markdown and get what the chunk leaves.
+		expandBudget := defaultExpandBudget
+		if budget > 0 {
+			expandBudget = budget - estimateTokens(chunk)
+		}
+
+		if expandBudget > 0 {
+			result.Linked, result.LinkedTruncated = a.expandLinks(ctx, object, min(params.Expand, maxExpandDepth), expandBudget)
+		} else {
+			result.LinkedTruncated = len(objectLinks(object)) > 0
+		}
 	}
 
-	return nil, &GetObjectResult{
-		ObjectId:   res.Object.ID,
-		SpaceId:    res.Object.SpaceId,
-		Markdown:   res.Object.Markdown,
-		Properties: props,
-	}, nil
+	return nil, result, nil
 }
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -121,7 +122,7 @@ func TestGetObject_Success(t *testing.T) {
 			},
 		},
 		{
-			name: "object with mixed properties - filtering test",
+			name: "object with mixed properties - empty values skipped",
 			params: GetObjectParams{
 				ObjectId: "mixed123",
 				SpaceId:  "spaceMixed",
@@ -189,6 +190,11 @@ func TestGetObject_Success(t *testing.T) {
 						Format: "date",
 						Value:  "2024-02-10",
 					},
+					{
+						Name:   "Is Active",
+						Format: "checkbox",
+						Value:  "false",
+					},
 				},
 			},
 		},
@@ -348,7 +354,7 @@ func TestGetObject_ErrorHandling(t *testing.T) {
 				Object:  "object",
 				Status:  404,
 			},
-			expectedError: "The object object returned an error: Object not found (code: not_found, status: 404)",
+			expectedError: "object nonexistent not found in space space123; try search to find the object id",
 		},
 		{
 			name: "unauthorized access",
@@ -363,7 +369,7 @@ func TestGetObject_ErrorHandling(t *testing.T) {
 				Object:  "auth",
 				Status:  401,
 			},
-			expectedError: "The object auth returned an error: Invalid API key (code: unauthorized, status: 401)",
+			expectedError: "Anytype rejected the API key; ask the user to pair again with the auth command or update ANYTYPE_API_KEY",
 		},
 		{
 			name: "bad request - missing object id",
@@ -410,6 +416,21 @@ func TestGetObject_ErrorHandling(t *testing.T) {
 			},
 			expectedError: "The object object returned an error: Access denied to this object (code: forbidden, status: 403)",
 		},
+		{
+			name: "anytype unavailable",
+			params: GetObjectParams{
+				ObjectId: "obj123",
+				SpaceId:  "space456",
+			},
+			statusCode: http.StatusServiceUnavailable,
+			mockError: &anytype.Error{
+				Code:    "unavailable",
+				Message: "Service unavailable",
+				Object:  "object",
+				Status:  503,
+			},
+			expectedError: "Anytype is not reachable; ask the user to make sure the Anytype desktop app is running",
+		},
 	}
 
 	for _, tt := range tests {
@@ -484,6 +505,7 @@ func TestGetObject_PropertyFiltering(t *testing.T) {
 					{Name: "Date2", Format: "date", Date: "2024-02-02"},
 					{Name: "Phone1", Format: "phone"},
 					{Name: "Select1", Format: "select"},
+					{Name: "Unknown1", Format: "unknown"},
 				},
 			},
 		}
@@ -507,15 +529,299 @@ func TestGetObject_PropertyFiltering(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	expectedCount := 4 // Both text and date properties
-	if len(result.Properties) != expectedCount {
-		t.Errorf("expected %d properties, got %d", expectedCount, len(result.Properties))
+	expected := []Property{
+		{Name: "Text1", Format: "text", Value: "value1"},
+		{Name: "Date1", Format: "date", Value: "2024-01-01"},
+		{Name: "Checkbox1", Format: "checkbox", Value: "false"},
+		{Name: "Text2", Format: "text", Value: "value2"},
+		{Name: "Date2", Format: "date", Value: "2024-02-02"},
+	}
+
+	if !reflect.DeepEqual(result.Properties, expected) {
+		t.Errorf("expected properties %+v, got %+v", expected, result.Properties)
+	}
+}
+
+func TestGetObject_LinkedObjects(t *testing.T) {
+	objects := map[string]anytype.Object{
+		"/v1/spaces/space1/objects/obj1": {
+			ID:      "obj1",
+			SpaceId: "space1",
+			Name:    "Meeting",
+			Properties: []anytype.Property{
+				{Name: "Attendees", Format: "objects", Objects: []string{"person1", "missing"}},
+				{Name: "Attachments", Format: "files", Files: []string{"file1"}},
+			},
+		},
+		"/v1/spaces/space1/objects/person1": {ID: "person1", Name: "Alice"},
+		"/v1/spaces/space1/objects/file1":   {ID: "file1", Name: "slides.pdf"},
 	}
 
-	for _, prop := range result.Properties {
-		if prop.Format != "text" && prop.Format != "date" {
-			t.Errorf("unexpected property format %s, expected only text or date", prop.Format)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		object, ok := objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&anytype.Error{Code: "not_found", Message: "Object not found", Status: 404})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&anytype.GetObjectOutput{Object: object})
+	}))
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	app := New(client)
+	req := &mcp.CallToolRequest{}
+
+	_, result, err := app.GetObject(context.Background(), req, GetObjectParams{
+		ObjectId: "obj1",
+		SpaceId:  "space1",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []Property{
+		{Name: "Attendees", Format: "objects", Value: "Alice (person1), missing"},
+		{Name: "Attachments", Format: "files", Value: "slides.pdf (file1)"},
+	}
+
+	if !reflect.DeepEqual(result.Properties, expected) {
+		t.Errorf("expected properties %+v, got %+v", expected, result.Properties)
+	}
+}
+
+func TestGetObject_TokenBudget(t *testing.T) {
+	markdown := "# Title\nFirst paragraph.\nSecond paragraph.\nThird paragraph."
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&anytype.GetObjectOutput{
+			Object: anytype.Object{
+				ID:         "obj123",
+				SpaceId:    "space456",
+				Markdown:   markdown,
+				Properties: []anytype.Property{{Name: "Title", Format: "text", Text: "Long"}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	app := New(client, WithTokenBudget(100))
+	req := &mcp.CallToolRequest{}
+
+	var chunks []string
+	params := GetObjectParams{ObjectId: "obj123", SpaceId: "space456", Budget: 7}
+	for page := 0; page < 10; page++ {
+		_, result, err := app.GetObject(context.Background(), req, params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if page == 0 && len(result.Properties) != 1 {
+			t.Errorf("expected properties on first page, got %+v", result.Properties)
 		}
+
+		if page > 0 && len(result.Properties) != 0 {
+			t.Errorf("expected no properties on page %d, got %+v", page, result.Properties)
+		}
+
+		chunks = append(chunks, result.Markdown)
+		if result.NextCursor == "" {
+			break
+		}
+		params.Cursor = result.NextCursor
+	}
+
+	expected := []string{"# Title\nFirst paragraph.\n", "Second paragraph.\n", "Third paragraph."}
+	if !reflect.DeepEqual(chunks, expected) {
+		t.Errorf("expected chunks %q, got %q", expected, chunks)
+	}
+}
+
+func TestGetObject_InvalidCursor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&anytype.GetObjectOutput{
+			Object: anytype.Object{ID: "obj123", Markdown: "short"},
+		})
+	}))
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	app := New(client)
+	req := &mcp.CallToolRequest{}
+
+	mcpResult, result, err := app.GetObject(context.Background(), req, GetObjectParams{
+		ObjectId: "obj123",
+		SpaceId:  "space456",
+		Cursor:   encodeCursor(100, time.Time{}),
+	})
+
+	if err != ErrInvalidCursor {
+		t.Fatalf("expected invalid cursor error, got %v", err)
+	}
+
+	if mcpResult == nil || !mcpResult.IsError {
+		t.Fatal("expected MCP error result")
+	}
+
+	if result != nil {
+		t.Error("expected nil result on error")
+	}
+}
+
+func TestGetObject_StaleCursor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&anytype.GetObjectOutput{
+			Object: anytype.Object{
+				ID:         "obj123",
+				Markdown:   "Rewritten content",
+				Properties: []anytype.Property{{Key: "last_modified_date", Format: "date", Date: "2024-05-01T11:00:00Z"}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	app := New(client)
+	req := &mcp.CallToolRequest{}
+
+	mcpResult, result, err := app.GetObject(context.Background(), req, GetObjectParams{
+		ObjectId: "obj123",
+		SpaceId:  "space456",
+		Cursor:   encodeCursor(5, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)),
+	})
+
+	if err != ErrStaleCursor {
+		t.Fatalf("expected stale cursor error, got %v", err)
+	}
+
+	if mcpResult == nil || !mcpResult.IsError {
+		t.Fatal("expected MCP error result")
+	}
+
+	if result != nil {
+		t.Error("expected nil result on error")
+	}
+}
+
+func TestGetObject_Sections(t *testing.T) {
+	markdown := "# Setup\nSetup text\n## Install\nRun it\n# Usage\nUse it\n"
+
+	tests := []struct {
+		name             string
+		params           GetObjectParams
+		expectedMarkdown string
+		expectedOutline  []OutlineItem
+		expectedError    string
+	}{
+		{
+			name:   "outline of object",
+			params: GetObjectParams{ObjectId: "obj1", SpaceId: "space1", Outline: true},
+			expectedOutline: []OutlineItem{
+				{ID: "1", Title: "Setup", Tokens: 10, Children: []OutlineItem{{ID: "1.1", Title: "Install", Tokens: 5}}},
+				{ID: "2", Title: "Usage", Tokens: 4},
+			},
+		},
+		{
+			name:   "outline of section keeps ids",
+			params: GetObjectParams{ObjectId: "obj1", SpaceId: "space1", Outline: true, Section: "Setup"},
+			expectedOutline: []OutlineItem{
+				{ID: "1", Title: "Setup", Tokens: 10, Children: []OutlineItem{{ID: "1.1", Title: "Install", Tokens: 5}}},
+			},
+		},
+		{
+			name:             "section by heading path",
+			params:           GetObjectParams{ObjectId: "obj1", SpaceId: "space1", Section: "Setup/Install"},
+			expectedMarkdown: "## Install\nRun it\n",
+		},
+		{
+			name:             "section by id",
+			params:           GetObjectParams{ObjectId: "obj1", SpaceId: "space1", Section: "2"},
+			expectedMarkdown: "# Usage\nUse it\n",
+		},
+		{
+			name:          "unknown section",
+			params:        GetObjectParams{ObjectId: "obj1", SpaceId: "space1", Section: "Missing"},
+			expectedError: `section "Missing" not found, use outline to list the sections`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(&anytype.GetObjectOutput{
+					Object: anytype.Object{ID: "obj1", SpaceId: "space1", Markdown: markdown},
+				})
+			}))
+			defer server.Close()
+
+			client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+			app := New(client)
+			req := &mcp.CallToolRequest{}
+
+			_, result, err := app.GetObject(context.Background(), req, tt.params)
+
+			if tt.expectedError != "" {
+				if err == nil || err.Error() != tt.expectedError {
+					t.Fatalf("expected error %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.Markdown != tt.expectedMarkdown {
+				t.Errorf("expected markdown %q, got %q", tt.expectedMarkdown, result.Markdown)
+			}
+
+			if !reflect.DeepEqual(result.Outline, tt.expectedOutline) {
+				t.Errorf("expected outline %+v, got %+v", tt.expectedOutline, result.Outline)
+			}
+		})
+	}
+}
+
+func TestGetObject_OutlineOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&anytype.GetObjectOutput{
+			Object: anytype.Object{
+				ID: "obj1", SpaceId: "space1", Markdown: "# Setup\nSee anytype://space1/obj2\n",
+				Properties: []anytype.Property{{Key: "status", Name: "Status", Format: "text", Text: "Draft"}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	app := New(anytype.New("test-api-key", anytype.WithApiServer(server.URL)))
+
+	_, result, err := app.GetObject(context.Background(), &mcp.CallToolRequest{}, GetObjectParams{ObjectId: "obj1", SpaceId: "space1", Outline: true, Expand: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Outline) != 1 {
+		t.Errorf("expected the heading tree, got %+v", result.Outline)
+	}
+
+	if len(result.Properties) != 0 || result.Linked != nil || result.Markdown != "" {
+		t.Errorf("expected only the heading tree in outline mode, got %+v", result)
 	}
 }
 
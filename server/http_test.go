@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type bearerTransport struct {
+	token string
+}
+
+func (t *bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if t.token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newTestMCPServer() *mcp.Server {
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "v0.0.0"}, nil)
+	app := New(nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "search", Description: "search objects in anytype"}, app.Search)
+	return server
+}
+
+func TestNewHTTPHandler_Auth(t *testing.T) {
+	tests := []struct {
+		name           string
+		serverToken    string
+		clientToken    string
+		expectedStatus int
+	}{
+		{"no token required", "", "", http.StatusOK},
+		{"missing token", "secret", "", http.StatusUnauthorized},
+		{"wrong token", "secret", "guess", http.StatusUnauthorized},
+		{"valid token", "secret", "secret", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(NewHTTPHandler(newTestMCPServer(), tt.serverToken))
+			defer server.Close()
+
+			req, err := http.NewRequest(http.MethodGet, server.URL+"/sse", nil)
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			client := &http.Client{Transport: &bearerTransport{token: tt.clientToken}}
+			resp, err := client.Do(req.WithContext(ctx))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestNewHTTPHandler_Transports(t *testing.T) {
+	tests := []struct {
+		name      string
+		transport func(url string, client *http.Client) mcp.Transport
+	}{
+		{
+			name: "streamable http",
+			transport: func(url string, client *http.Client) mcp.Transport {
+				return &mcp.StreamableClientTransport{Endpoint: url + "/mcp", HTTPClient: client, MaxRetries: -1}
+			},
+		},
+		{
+			name: "legacy sse",
+			transport: func(url string, client *http.Client) mcp.Transport {
+				return &mcp.SSEClientTransport{Endpoint: url + "/sse", HTTPClient: client}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(NewHTTPHandler(newTestMCPServer(), "secret"))
+			defer server.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			httpClient := &http.Client{Transport: &bearerTransport{token: "secret"}}
+			client := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "v0.0.0"}, nil)
+			session, err := client.Connect(ctx, tt.transport(server.URL, httpClient), nil)
+			if err != nil {
+				t.Fatalf("failed to connect: %v", err)
+			}
+			defer session.Close()
+
+			tools, err := session.ListTools(ctx, nil)
+			if err != nil {
+				t.Fatalf("failed to list tools: %v", err)
+			}
+
+			if len(tools.Tools) != 1 || tools.Tools[0].Name != "search" {
+				t.Errorf("expected search tool, got %+v", tools.Tools)
+			}
+		})
+	}
+}
+
+func TestListenAndServe_GracefulShutdown(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- ListenAndServe(ctx, listener, NewHTTPHandler(newTestMCPServer(), ""))
+	}()
+
+	resp, err := http.Get("http://" + listener.Addr().String() + "/mcp")
+	if err != nil {
+		t.Fatalf("expected server to be reachable, got %v", err)
+	}
+	resp.Body.Close()
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("expected graceful shutdown, got %v", err)
+		}
+	case <-time.After(shutdownTimeout + time.Second):
+		t.Fatal("expected server to shut down")
+	}
+}
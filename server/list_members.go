@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// memberCacheTTL is the time to reuse the member names of a space for resolving properties.
+const memberCacheTTL = 5 * time.Minute
+
+type ListMembersParams struct {
+	SpaceId string `json:"spaceId" jsonschema:"the id of the space"`
+}
+
+type MemberItem struct {
+	ID         string `json:"id" jsonschema:"the id of the member"`
+	Name       string `json:"name" jsonschema:"the name of the member"`
+	GlobalName string `json:"global_name,omitempty" jsonschema:"the global name of the member, e.g. alice.any"`
+	Role       string `json:"role,omitempty" jsonschema:"the role of the member, e.g. owner, editor or viewer"`
+	Status     string `json:"status,omitempty" jsonschema:"the status of the member, e.g. active or joining"`
+}
+
+type ListMembersResult struct {
+	Data []MemberItem `json:"data" jsonschema:"the members of the space"`
+}
+
+type memberCache struct {
+	names     map[string]string
+	fetchedAt time.Time
+}
+
+func (a *App) ListMembers(ctx context.Context, req *mcp.CallToolRequest, params ListMembersParams) (*mcp.CallToolResult, *ListMembersResult, error) {
+	members, err := a.fetchMembers(ctx, params.SpaceId)
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("space %s not found; use list-spaces to find the space id", params.SpaceId))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	items := make([]MemberItem, len(members))
+	for i, member := range members {
+		items[i] = MemberItem{
+			ID:         member.ID,
+			Name:       member.Name,
+			GlobalName: member.GlobalName,
+			Role:       member.Role,
+			Status:     member.Status,
+		}
+	}
+
+	return nil, &ListMembersResult{Data: items}, nil
+}
+
+// fetchMembers lists the members of the space and refreshes the cached member names.
+func (a *App) fetchMembers(ctx context.Context, spaceId string) ([]anytype.Member, error) {
+	members, err := anytype.Collect(a.anytype.ListMembersAll(ctx, anytype.ListMembersInput{
+		Params: anytype.ListMembersParams{SpaceId: spaceId},
+	}, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(members))
+	for _, member := range members {
+		names[member.ID] = memberName(member)
+	}
+
+	a.membersMu.Lock()
+	defer a.membersMu.Unlock()
+
+	if a.members == nil {
+		a.members = make(map[string]memberCache)
+	}
+	a.members[spaceId] = memberCache{names: names, fetchedAt: time.Now()}
+
+	return members, nil
+}
+
+// memberNames returns the cached member id to name map of the space, it is empty when the members cannot be listed.
+func (a *App) memberNames(ctx context.Context, spaceId string) map[string]string {
+	a.membersMu.Lock()
+	cached, ok := a.members[spaceId]
+	a.membersMu.Unlock()
+
+	if ok && time.Since(cached.fetchedAt) < memberCacheTTL {
+		return cached.names
+	}
+
+	if _, err := a.fetchMembers(ctx, spaceId); err != nil {
+		return cached.names
+	}
+
+	a.membersMu.Lock()
+	defer a.membersMu.Unlock()
+
+	return a.members[spaceId].names
+}
+
+// isMemberId reports whether the linked id is a space member instead of an object.
+func isMemberId(id string) bool {
+	return strings.HasPrefix(id, "_participant_")
+}
+
+func memberName(member anytype.Member) string {
+	switch {
+	case member.Name != "":
+		return member.Name
+	case member.GlobalName != "":
+		return member.GlobalName
+	}
+
+	return member.Identity
+}
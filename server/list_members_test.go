@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// newMemberServer serves the members of space1 and obj1 created by Alice, it counts the member list requests.
+func newMemberServer(t *testing.T) (*App, *atomic.Int32) {
+	t.Helper()
+
+	requests := &atomic.Int32{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/spaces/space1/members", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		json.NewEncoder(w).Encode(&anytype.ListMembersOutput{
+			Data: []anytype.Member{
+				{ID: "_participant_space1_alice", Name: "Alice", GlobalName: "alice.any", Role: "owner", Status: "active"},
+				{ID: "_participant_space1_bob", GlobalName: "bob.any", Role: "editor", Status: "active"},
+			},
+		})
+	})
+	mux.HandleFunc("GET /v1/spaces/space1/objects/obj1", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&anytype.GetObjectOutput{
+			Object: anytype.Object{
+				ID:      "obj1",
+				SpaceId: "space1",
+				Properties: []anytype.Property{
+					{Key: "creator", Name: "Created by", Format: "objects", Objects: []string{"_participant_space1_alice"}},
+					{Key: "last_modified_by", Name: "Last modified by", Format: "objects", Objects: []string{"_participant_space1_bob", "_participant_space1_left"}},
+				},
+			},
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return New(anytype.New("test-api-key", anytype.WithApiServer(server.URL))), requests
+}
+
+func TestListMembers(t *testing.T) {
+	app, _ := newMemberServer(t)
+
+	_, result, err := app.ListMembers(context.Background(), &mcp.CallToolRequest{}, ListMembersParams{SpaceId: "space1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &ListMembersResult{
+		Data: []MemberItem{
+			{ID: "_participant_space1_alice", Name: "Alice", GlobalName: "alice.any", Role: "owner", Status: "active"},
+			{ID: "_participant_space1_bob", GlobalName: "bob.any", Role: "editor", Status: "active"},
+		},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected result %+v, got %+v", expected, result)
+	}
+}
+
+func TestGetObject_MemberProperties(t *testing.T) {
+	app, requests := newMemberServer(t)
+
+	expected := []Property{
+		{Name: "Created by", Format: "objects", Value: "Alice (_participant_space1_alice)"},
+		{Name: "Last modified by", Format: "objects", Value: "bob.any (_participant_space1_bob), _participant_space1_left"},
+	}
+
+	for range 2 {
+		_, result, err := app.GetObject(context.Background(), &mcp.CallToolRequest{}, GetObjectParams{ObjectId: "obj1", SpaceId: "space1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(result.Properties, expected) {
+			t.Errorf("expected properties %+v, got %+v", expected, result.Properties)
+		}
+	}
+
+	if requests.Load() != 1 {
+		t.Errorf("expected members to be listed once, got %d", requests.Load())
+	}
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ListSpacesParams struct {
+	Offset int `json:"offset,omitempty" jsonschema:"the offset for pagination"`
+}
+
+type SpaceItem struct {
+	ID          string `json:"id" jsonschema:"the id of the space"`
+	Name        string `json:"name" jsonschema:"the name of the space"`
+	Description string `json:"description,omitempty" jsonschema:"the description of the space"`
+}
+
+type ListSpacesResult struct {
+	Data       []SpaceItem `json:"data" jsonschema:"the spaces"`
+	Pagination Pagination  `json:"pagination" jsonschema:"the pagination info"`
+}
+
+func (a *App) ListSpaces(ctx context.Context, req *mcp.CallToolRequest, params ListSpacesParams) (*mcp.CallToolResult, *ListSpacesResult, error) {
+	res, err := a.anytype.ListSpaces(ctx, anytype.ListSpacesInput{
+		Params: anytype.ListSpacesParams{
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		err = explainError(err, "")
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	items := make([]SpaceItem, len(res.Data))
+	for i, space := range res.Data {
+		items[i] = SpaceItem{
+			ID:          space.ID,
+			Name:        space.Name,
+			Description: space.Description,
+		}
+	}
+
+	return nil, &ListSpacesResult{
+		Data: items,
+		Pagination: Pagination{
+			Total:  res.Pagination.Total,
+			Offset: res.Pagination.Offset,
+		},
+	}, nil
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestListSpaces_Success(t *testing.T) {
+	tests := []struct {
+		name           string
+		params         ListSpacesParams
+		mockResponse   *anytype.ListSpacesOutput
+		expectedParams string
+		expectedResult *ListSpacesResult
+	}{
+		{
+			name:   "spaces with descriptions",
+			params: ListSpacesParams{},
+			mockResponse: &anytype.ListSpacesOutput{
+				Data: []anytype.Space{
+					{ID: "space1", Name: "Work", Description: "Team notes"},
+					{ID: "space2", Name: "Personal"},
+				},
+				Pagination: anytype.Pagination{Total: 2, Offset: 0, Limit: 100},
+			},
+			expectedParams: "offset=0",
+			expectedResult: &ListSpacesResult{
+				Data: []SpaceItem{
+					{ID: "space1", Name: "Work", Description: "Team notes"},
+					{ID: "space2", Name: "Personal"},
+				},
+				Pagination: Pagination{Total: 2, Offset: 0},
+			},
+		},
+		{
+			name:   "empty page with offset",
+			params: ListSpacesParams{Offset: 10},
+			mockResponse: &anytype.ListSpacesOutput{
+				Data:       []anytype.Space{},
+				Pagination: anytype.Pagination{Total: 2, Offset: 10},
+			},
+			expectedParams: "offset=10",
+			expectedResult: &ListSpacesResult{
+				Data:       []SpaceItem{},
+				Pagination: Pagination{Total: 2, Offset: 10},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/spaces" {
+					t.Errorf("expected path /v1/spaces, got %s", r.URL.Path)
+				}
+
+				if r.URL.RawQuery != tt.expectedParams {
+					t.Errorf("expected query params %s, got %s", tt.expectedParams, r.URL.RawQuery)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(tt.mockResponse)
+			}))
+			defer server.Close()
+
+			client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+			app := New(client)
+			req := &mcp.CallToolRequest{}
+
+			mcpResult, result, err := app.ListSpaces(context.Background(), req, tt.params)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if mcpResult != nil {
+				t.Fatal("expected nil MCP result for success")
+			}
+
+			if !reflect.DeepEqual(result, tt.expectedResult) {
+				t.Errorf("expected result %+v, got %+v", tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestListSpaces_ErrorHandling(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(&anytype.Error{Code: "unauthorized", Message: "Invalid API key", Object: "auth", Status: 401})
+	}))
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	app := New(client)
+	req := &mcp.CallToolRequest{}
+
+	mcpResult, result, err := app.ListSpaces(context.Background(), req, ListSpacesParams{})
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if mcpResult == nil || !mcpResult.IsError {
+		t.Fatal("expected MCP error result")
+	}
+
+	if result != nil {
+		t.Error("expected nil result on error")
+	}
+}
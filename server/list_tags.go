@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ListTagsParams struct {
+	SpaceId  string `json:"spaceId" jsonschema:"the space id of the property"`
+	Property string `json:"property" jsonschema:"the key or name of the select or multi_select property, e.g. status"`
+}
+
+type TagItem struct {
+	ID    string `json:"id" jsonschema:"the id of the tag"`
+	Name  string `json:"name" jsonschema:"the name of the tag, used as property value"`
+	Color string `json:"color,omitempty" jsonschema:"the color of the tag"`
+}
+
+type ListTagsResult struct {
+	Property string    `json:"property" jsonschema:"the key of the property"`
+	Data     []TagItem `json:"data" jsonschema:"the tags of the property"`
+}
+
+func (a *App) ListTags(ctx context.Context, req *mcp.CallToolRequest, params ListTagsParams) (*mcp.CallToolResult, *ListTagsResult, error) {
+	result, err := a.listTags(ctx, params)
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("space %s not found; use list-spaces to find the space id", params.SpaceId))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	return nil, result, nil
+}
+
+func (a *App) listTags(ctx context.Context, params ListTagsParams) (*ListTagsResult, error) {
+	def, err := a.selectProperty(ctx, params.SpaceId, params.Property)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := a.propertyTags(ctx, params.SpaceId, def.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListTagsResult{Property: def.Key, Data: make([]TagItem, len(tags))}
+	for i, tag := range tags {
+		result.Data[i] = TagItem{ID: tag.ID, Name: tag.Name, Color: tag.Color}
+	}
+
+	return result, nil
+}
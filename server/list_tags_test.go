@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// newTagServer serves the status select property with the To Do and Done tags in space1,
+// the tag writes respond with the tag merged with the request body.
+func newTagServer(t *testing.T) *App {
+	t.Helper()
+
+	tags := map[string]anytype.Tag{
+		"tag1": {ID: "tag1", Key: "todo", Name: "To Do", Color: "grey"},
+		"tag2": {ID: "tag2", Key: "done", Name: "Done", Color: "lime"},
+	}
+
+	writeTag := func(w http.ResponseWriter, tag anytype.Tag) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&anytype.TagOutput{Tag: tag})
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/spaces/space1/properties", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&anytype.ListPropertiesOutput{
+			Data: []anytype.PropertyDefinition{
+				{ID: "prop1", Key: "status", Name: "Status", Format: "select"},
+				{ID: "prop2", Key: "description", Name: "Description", Format: "text"},
+			},
+		})
+	})
+	mux.HandleFunc("GET /v1/spaces/space1/properties/prop1/tags", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&anytype.ListTagsOutput{Data: []anytype.Tag{tags["tag1"], tags["tag2"]}})
+	})
+	mux.HandleFunc("POST /v1/spaces/space1/properties/prop1/tags", func(w http.ResponseWriter, r *http.Request) {
+		var body anytype.CreateTagBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+
+		writeTag(w, anytype.Tag{ID: "tag3", Name: body.Name, Color: body.Color})
+	})
+	mux.HandleFunc("PATCH /v1/spaces/space1/properties/prop1/tags/{tagId}", func(w http.ResponseWriter, r *http.Request) {
+		var body anytype.UpdateTagBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+
+		tag := tags[r.PathValue("tagId")]
+		if body.Name != "" {
+			tag.Name = body.Name
+		}
+		if body.Color != "" {
+			tag.Color = body.Color
+		}
+		writeTag(w, tag)
+	})
+	mux.HandleFunc("DELETE /v1/spaces/space1/properties/prop1/tags/{tagId}", func(w http.ResponseWriter, r *http.Request) {
+		writeTag(w, tags[r.PathValue("tagId")])
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return New(anytype.New("test-api-key", anytype.WithApiServer(server.URL)))
+}
+
+func TestListTags(t *testing.T) {
+	app := newTagServer(t)
+
+	tests := []struct {
+		name     string
+		params   ListTagsParams
+		expected *ListTagsResult
+		wantErr  bool
+	}{
+		{
+			name:   "property by key",
+			params: ListTagsParams{SpaceId: "space1", Property: "status"},
+			expected: &ListTagsResult{
+				Property: "status",
+				Data: []TagItem{
+					{ID: "tag1", Name: "To Do", Color: "grey"},
+					{ID: "tag2", Name: "Done", Color: "lime"},
+				},
+			},
+		},
+		{
+			name:   "property by name",
+			params: ListTagsParams{SpaceId: "space1", Property: "Status"},
+			expected: &ListTagsResult{
+				Property: "status",
+				Data: []TagItem{
+					{ID: "tag1", Name: "To Do", Color: "grey"},
+					{ID: "tag2", Name: "Done", Color: "lime"},
+				},
+			},
+		},
+		{
+			name:    "property without tags",
+			params:  ListTagsParams{SpaceId: "space1", Property: "description"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown property",
+			params:  ListTagsParams{SpaceId: "space1", Property: "priority"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, result, err := app.ListTags(context.Background(), &mcp.CallToolRequest{}, tt.params)
+			if tt.wantErr {
+				if err == nil || res == nil || !res.IsError {
+					t.Fatalf("expected error result, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected result %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}
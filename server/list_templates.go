@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ListTemplatesParams struct {
+	SpaceId    string `json:"spaceId" jsonschema:"the space id of the type"`
+	Type       string `json:"type" jsonschema:"the type key of the templates, e.g. page or task"`
+	TemplateId string `json:"templateId,omitempty" jsonschema:"return only the template with its markdown to preview the structure"`
+}
+
+type TemplateItem struct {
+	ID       string `json:"id" jsonschema:"the id of the template, used as templateId to create object"`
+	Name     string `json:"name" jsonschema:"the name of the template"`
+	Markdown string `json:"markdown,omitempty" jsonschema:"the markdown of the template, only returned with templateId"`
+}
+
+type ListTemplatesResult struct {
+	Type string         `json:"type" jsonschema:"the key of the type"`
+	Data []TemplateItem `json:"data" jsonschema:"the templates of the type"`
+}
+
+func (a *App) ListTemplates(ctx context.Context, req *mcp.CallToolRequest, params ListTemplatesParams) (*mcp.CallToolResult, *ListTemplatesResult, error) {
+	result, err := a.listTemplates(ctx, params)
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("space %s or template %s not found; use list-spaces and list-templates to find them", params.SpaceId, params.TemplateId))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	return nil, result, nil
+}
+
+func (a *App) listTemplates(ctx context.Context, params ListTemplatesParams) (*ListTemplatesResult, error) {
+	objectType, err := a.spaceType(ctx, params.SpaceId, params.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListTemplatesResult{Type: objectType.Key}
+	if params.TemplateId != "" {
+		res, err := a.anytype.GetTemplate(ctx, anytype.GetTemplateInput{
+			Params: anytype.GetTemplateParams{SpaceId: params.SpaceId, TypeId: objectType.ID, TemplateId: params.TemplateId},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result.Data = []TemplateItem{{ID: res.Template.ID, Name: res.Template.Name, Markdown: res.Template.Markdown}}
+		return result, nil
+	}
+
+	templates, err := anytype.Collect(a.anytype.ListTemplatesAll(ctx, anytype.ListTemplatesInput{
+		Params: anytype.ListTemplatesParams{SpaceId: params.SpaceId, TypeId: objectType.ID},
+	}, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	result.Data = make([]TemplateItem, len(templates))
+	for i, template := range templates {
+		result.Data[i] = TemplateItem{ID: template.ID, Name: template.Name}
+	}
+
+	return result, nil
+}
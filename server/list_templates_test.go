@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestListTemplates(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/spaces/space1/types", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&anytype.ListTypesOutput{
+			Data: []anytype.ObjectType{{ID: "type1", Key: "page", Name: "Page"}},
+		})
+	})
+	mux.HandleFunc("GET /v1/spaces/space1/types/type1/templates", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&anytype.ListTemplatesOutput{
+			Data: []anytype.Object{{ID: "tpl1", Name: "Meeting Notes"}, {ID: "tpl2", Name: "ADR"}},
+		})
+	})
+	mux.HandleFunc("GET /v1/spaces/space1/types/type1/templates/tpl2", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&anytype.GetTemplateOutput{
+			Template: anytype.Object{ID: "tpl2", Name: "ADR", Markdown: "## Context\n\n## Decision"},
+		})
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"not_found","message":"template not found","object":"error","status":404}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	app := New(anytype.New("test-api-key", anytype.WithApiServer(server.URL)))
+
+	tests := []struct {
+		name     string
+		params   ListTemplatesParams
+		expected *ListTemplatesResult
+		wantErr  bool
+	}{
+		{
+			name:   "templates of type",
+			params: ListTemplatesParams{SpaceId: "space1", Type: "page"},
+			expected: &ListTemplatesResult{
+				Type: "page",
+				Data: []TemplateItem{{ID: "tpl1", Name: "Meeting Notes"}, {ID: "tpl2", Name: "ADR"}},
+			},
+		},
+		{
+			name:   "template with markdown",
+			params: ListTemplatesParams{SpaceId: "space1", Type: "Page", TemplateId: "tpl2"},
+			expected: &ListTemplatesResult{
+				Type: "page",
+				Data: []TemplateItem{{ID: "tpl2", Name: "ADR", Markdown: "## Context\n\n## Decision"}},
+			},
+		},
+		{
+			name:    "unknown type",
+			params:  ListTemplatesParams{SpaceId: "space1", Type: "adr"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown template",
+			params:  ListTemplatesParams{SpaceId: "space1", Type: "page", TemplateId: "tpl3"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, result, err := app.ListTemplates(context.Background(), &mcp.CallToolRequest{}, tt.params)
+			if tt.wantErr {
+				if err == nil || res == nil || !res.IsError {
+					t.Fatalf("expected error result, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected result %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ListViewObjectsParams struct {
+	SpaceId    string   `json:"spaceId" jsonschema:"the space id of the set or collection"`
+	ListId     string   `json:"listId" jsonschema:"the object id of the set or collection"`
+	ViewId     string   `json:"viewId,omitempty" jsonschema:"the id of the view, the first view is used when omitted"`
+	Properties []string `json:"properties,omitempty" jsonschema:"the property keys to include in each row, defaults to the visible properties of the view"`
+	Offset     int      `json:"offset,omitempty" jsonschema:"the offset for pagination"`
+}
+
+type ViewItem struct {
+	ID   string `json:"id" jsonschema:"the id of the view"`
+	Name string `json:"name" jsonschema:"the name of the view"`
+}
+
+type ViewRow struct {
+	ID         string            `json:"id" jsonschema:"the id of the object"`
+	Name       string            `json:"name" jsonschema:"the name of the object"`
+	Properties map[string]string `json:"properties,omitempty" jsonschema:"the property name to value map of the row"`
+}
+
+type ListViewObjectsResult struct {
+	View       ViewItem   `json:"view" jsonschema:"the view of the rows"`
+	Views      []ViewItem `json:"views,omitempty" jsonschema:"the available views when no view is given"`
+	Data       []ViewRow  `json:"data" jsonschema:"the objects in the view"`
+	Pagination Pagination `json:"pagination" jsonschema:"the pagination info"`
+}
+
+func (a *App) ListViewObjects(ctx context.Context, req *mcp.CallToolRequest, params ListViewObjectsParams) (*mcp.CallToolResult, *ListViewObjectsResult, error) {
+	result, err := a.listViewObjects(ctx, params)
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("list %s or its view not found in space %s; try search to find the list id", params.ListId, params.SpaceId))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	return nil, result, nil
+}
+
+func (a *App) listViewObjects(ctx context.Context, params ListViewObjectsParams) (*ListViewObjectsResult, error) {
+	views, err := anytype.Collect(a.anytype.ListViewsAll(ctx, anytype.ListViewsInput{
+		Params: anytype.ListViewsParams{
+			SpaceId: params.SpaceId,
+			ListId:  params.ListId,
+		},
+	}, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(views) == 0 {
+		return nil, errors.New("the list has no views")
+	}
+
+	result := &ListViewObjectsResult{}
+	view := views[0]
+	if params.ViewId == "" {
+		for _, v := range views {
+			result.Views = append(result.Views, ViewItem{ID: v.ID, Name: v.Name})
+		}
+	} else {
+		idx := slices.IndexFunc(views, func(v anytype.View) bool { return v.ID == params.ViewId })
+		if idx < 0 {
+			ids := make([]string, 0, len(views))
+			for _, v := range views {
+				ids = append(ids, v.ID+" ("+v.Name+")")
+			}
+
+			return nil, fmt.Errorf("view %s not found in list %s, expected one of: %s", params.ViewId, params.ListId, strings.Join(ids, ", "))
+		}
+		view = views[idx]
+	}
+	result.View = ViewItem{ID: view.ID, Name: view.Name}
+
+	res, err := a.anytype.ListViewObjects(ctx, anytype.ListViewObjectsInput{
+		Params: anytype.ListViewObjectsParams{
+			SpaceId: params.SpaceId,
+			ListId:  params.ListId,
+			ViewId:  view.ID,
+			Offset:  params.Offset,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := params.Properties
+	if len(keys) == 0 {
+		keys = viewPropertyKeys(view)
+	}
+
+	var linked []anytype.Property
+	for _, object := range res.Data {
+		for _, prop := range object.Properties {
+			if slices.Contains(keys, prop.Key) {
+				linked = append(linked, prop)
+			}
+		}
+	}
+	names := a.linkedNames(ctx, params.SpaceId, linked, false)
+
+	result.Data = make([]ViewRow, 0, len(res.Data))
+	for _, object := range res.Data {
+		row := ViewRow{ID: object.ID, Name: object.Name}
+		for _, prop := range object.Properties {
+			if !slices.Contains(keys, prop.Key) {
+				continue
+			}
+
+			value, ok := propertyValue(prop, names)
+			if !ok {
+				continue
+			}
+
+			if row.Properties == nil {
+				row.Properties = make(map[string]string)
+			}
+			row.Properties[prop.Name] = value
+		}
+
+		result.Data = append(result.Data, row)
+	}
+
+	result.Pagination = Pagination{
+		Total:  res.Pagination.Total,
+		Offset: res.Pagination.Offset,
+	}
+
+	return result, nil
+}
+
+// viewPropertyKeys returns the keys of the visible properties of the view,
+// or the keys the view filters and sorts by when it has no property columns.
+func viewPropertyKeys(view anytype.View) []string {
+	if len(view.Properties) > 0 {
+		keys := make([]string, 0, len(view.Properties))
+		for _, prop := range view.Properties {
+			if prop.Visible {
+				keys = append(keys, prop.PropertyKey)
+			}
+		}
+
+		return keys
+	}
+
+	keys := make([]string, 0, len(view.Filters)+len(view.Sorts))
+	for _, filter := range view.Filters {
+		if !slices.Contains(keys, filter.PropertyKey) {
+			keys = append(keys, filter.PropertyKey)
+		}
+	}
+
+	for _, sort := range view.Sorts {
+		if !slices.Contains(keys, sort.PropertyKey) {
+			keys = append(keys, sort.PropertyKey)
+		}
+	}
+
+	return keys
+}
@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestListViewObjects_Success(t *testing.T) {
+	views := &anytype.ListViewsOutput{
+		Data: []anytype.View{
+			{
+				ID:      "view1",
+				Name:    "Open",
+				Filters: []anytype.ViewFilter{{PropertyKey: "done"}},
+				Sorts:   []anytype.ViewSort{{PropertyKey: "due_date"}, {PropertyKey: "done"}},
+			},
+			{ID: "view2", Name: "All"},
+			{
+				ID:         "view3",
+				Name:       "Board",
+				Filters:    []anytype.ViewFilter{{PropertyKey: "done"}},
+				Properties: []anytype.ViewProperty{{PropertyKey: "owner", Visible: true}, {PropertyKey: "done"}},
+			},
+		},
+	}
+
+	objects := &anytype.ListViewObjectsOutput{
+		Data: []anytype.Object{
+			{
+				ID:   "obj1",
+				Name: "Write report",
+				Properties: []anytype.Property{
+					{Key: "done", Name: "Done", Format: "checkbox"},
+					{Key: "due_date", Name: "Due Date", Format: "date", Date: "2024-05-01"},
+					{Key: "owner", Name: "Owner", Format: "objects", Objects: []string{"person1"}},
+				},
+			},
+			{ID: "obj2", Name: "Untitled"},
+		},
+		Pagination: anytype.Pagination{Total: 12, Offset: 10},
+	}
+
+	tests := []struct {
+		name         string
+		params       ListViewObjectsParams
+		expectedView string
+		expected     *ListViewObjectsResult
+	}{
+		{
+			name:         "default view with view property keys",
+			params:       ListViewObjectsParams{SpaceId: "space1", ListId: "list1", Offset: 10},
+			expectedView: "view1",
+			expected: &ListViewObjectsResult{
+				View:  ViewItem{ID: "view1", Name: "Open"},
+				Views: []ViewItem{{ID: "view1", Name: "Open"}, {ID: "view2", Name: "All"}, {ID: "view3", Name: "Board"}},
+				Data: []ViewRow{
+					{ID: "obj1", Name: "Write report", Properties: map[string]string{"Done": "false", "Due Date": "2024-05-01"}},
+					{ID: "obj2", Name: "Untitled"},
+				},
+				Pagination: Pagination{Total: 12, Offset: 10},
+			},
+		},
+		{
+			name:         "selected view with requested properties",
+			params:       ListViewObjectsParams{SpaceId: "space1", ListId: "list1", ViewId: "view2", Properties: []string{"owner"}},
+			expectedView: "view2",
+			expected: &ListViewObjectsResult{
+				View: ViewItem{ID: "view2", Name: "All"},
+				Data: []ViewRow{
+					{ID: "obj1", Name: "Write report", Properties: map[string]string{"Owner": "Alice (person1)"}},
+					{ID: "obj2", Name: "Untitled"},
+				},
+				Pagination: Pagination{Total: 12, Offset: 10},
+			},
+		},
+		{
+			name:         "selected view with visible properties",
+			params:       ListViewObjectsParams{SpaceId: "space1", ListId: "list1", ViewId: "view3"},
+			expectedView: "view3",
+			expected: &ListViewObjectsResult{
+				View: ViewItem{ID: "view3", Name: "Board"},
+				Data: []ViewRow{
+					{ID: "obj1", Name: "Write report", Properties: map[string]string{"Owner": "Alice (person1)"}},
+					{ID: "obj2", Name: "Untitled"},
+				},
+				Pagination: Pagination{Total: 12, Offset: 10},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.URL.Path {
+				case "/v1/spaces/space1/lists/list1/views":
+					json.NewEncoder(w).Encode(views)
+				case "/v1/spaces/space1/lists/list1/views/" + tt.expectedView + "/objects":
+					json.NewEncoder(w).Encode(objects)
+				case "/v1/spaces/space1/objects/person1":
+					json.NewEncoder(w).Encode(&anytype.GetObjectOutput{Object: anytype.Object{ID: "person1", Name: "Alice"}})
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+			app := New(client)
+			req := &mcp.CallToolRequest{}
+
+			mcpResult, result, err := app.ListViewObjects(context.Background(), req, tt.params)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if mcpResult != nil {
+				t.Fatal("expected nil MCP result for success")
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected result %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestListViewObjects_NoViews(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&anytype.ListViewsOutput{})
+	}))
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	app := New(client)
+	req := &mcp.CallToolRequest{}
+
+	mcpResult, result, err := app.ListViewObjects(context.Background(), req, ListViewObjectsParams{SpaceId: "space1", ListId: "list1"})
+
+	if err == nil || err.Error() != "the list has no views" {
+		t.Fatalf("expected no views error, got %v", err)
+	}
+
+	if mcpResult == nil || !mcpResult.IsError {
+		t.Fatal("expected MCP error result")
+	}
+
+	if result != nil {
+		t.Error("expected nil result on error")
+	}
+}
+
+func TestListViewObjects_UnknownView(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/spaces/space1/lists/list1/views" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&anytype.ListViewsOutput{Data: []anytype.View{{ID: "view1", Name: "Open"}}})
+	}))
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	app := New(client)
+	req := &mcp.CallToolRequest{}
+
+	mcpResult, result, err := app.ListViewObjects(context.Background(), req, ListViewObjectsParams{SpaceId: "space1", ListId: "list1", ViewId: "view9"})
+
+	expected := "view view9 not found in list list1, expected one of: view1 (Open)"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected error %q, got %v", expected, err)
+	}
+
+	if mcpResult == nil || !mcpResult.IsError {
+		t.Fatal("expected MCP error result")
+	}
+
+	if result != nil {
+		t.Error("expected nil result on error")
+	}
+}
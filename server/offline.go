@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/elct9620/anytype-mcp-lite/pkg/mirror"
+)
+
+// offline reports whether the request can be served by the mirror because Anytype is not reachable.
+func (a *App) offline(err error) bool {
+	if a.mirror == nil || !errors.Is(err, anytype.ErrServerUnavailable) {
+		return false
+	}
+
+	_ = a.mirror.Refresh()
+	return len(a.mirror.Spaces()) > 0
+}
+
+// staleness tells the model the result is from the mirror and may be outdated.
+func staleness(syncedAt time.Time) string {
+	return fmt.Sprintf("Anytype is not reachable, served from the offline mirror synced at %s which may be outdated", syncedAt.Format(time.RFC3339))
+}
+
+// fetchObject gets the object from Anytype, or from the mirror with the staleness when Anytype is not reachable.
+func (a *App) fetchObject(ctx context.Context, spaceId, objectId string) (*anytype.Object, string, error) {
+	res, err := a.anytype.GetObject(ctx, anytype.GetObjectInput{
+		Params: anytype.GetObjectParams{
+			ObjectId: objectId,
+			SpaceId:  spaceId,
+		},
+	})
+	if err == nil {
+		return &res.Object, "", nil
+	}
+
+	if !a.offline(err) {
+		return nil, "", err
+	}
+
+	object, syncedAt, ok := a.mirror.Object(spaceId, objectId)
+	if !ok {
+		return nil, "", err
+	}
+
+	return &object.Object, staleness(syncedAt), nil
+}
+
+func (a *App) searchMirror(params SearchParams) *SearchResult {
+	res := a.mirror.Search(mirror.Query{
+		Text:    params.Query,
+		SpaceId: params.SpaceId,
+		Types:   params.Types,
+		Offset:  params.Offset,
+		Limit:   params.Limit,
+	})
+
+	items := make([]SearchItem, len(res.Data))
+	for i, item := range res.Data {
+		items[i] = SearchItem{
+			ID:      item.ID,
+			SpaceId: item.SpaceId,
+			Name:    item.Name,
+			Type:    item.Type.Name,
+		}
+	}
+
+	return &SearchResult{
+		Data: items,
+		Pagination: Pagination{
+			Total:  res.Total,
+			Offset: params.Offset,
+		},
+		Stale: staleness(res.SyncedAt),
+	}
+}
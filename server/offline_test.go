@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/elct9620/anytype-mcp-lite/pkg/mirror"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const testMirror = `{
+  "spaces": {
+    "space1": {
+      "id": "space1",
+      "name": "Work",
+      "synced_at": "2025-05-20T12:00:00Z",
+      "last_modified_date": "2025-05-20T11:00:00Z",
+      "objects": {
+        "obj1": {
+          "id": "obj1",
+          "space_id": "space1",
+          "name": "Roadmap",
+          "markdown": "# Goals\nShip the offline mode",
+          "type": {"key": "page", "name": "Page"},
+          "properties": [
+            {"key": "related", "name": "Related", "format": "objects", "objects": ["obj2"]}
+          ],
+          "last_modified_date": "2025-05-20T11:00:00Z"
+        },
+        "obj2": {
+          "id": "obj2",
+          "space_id": "space1",
+          "name": "Meeting Notes",
+          "markdown": "Discuss the roadmap",
+          "type": {"key": "page", "name": "Page"},
+          "last_modified_date": "2025-05-20T10:00:00Z"
+        }
+      }
+    }
+  }
+}`
+
+const testStaleness = "Anytype is not reachable, served from the offline mirror synced at 2025-05-20T12:00:00Z which may be outdated"
+
+func newOfflineApp(t *testing.T, withMirror bool) *App {
+	t.Helper()
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(unreachable.URL))
+	if !withMirror {
+		return New(client)
+	}
+
+	path := filepath.Join(t.TempDir(), "mirror.json")
+	if err := os.WriteFile(path, []byte(testMirror), 0o600); err != nil {
+		t.Fatalf("failed to write mirror: %v", err)
+	}
+
+	store, err := mirror.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open mirror: %v", err)
+	}
+
+	return New(client, WithMirror(store))
+}
+
+func TestSearch_Offline(t *testing.T) {
+	app := newOfflineApp(t, true)
+
+	_, result, err := app.Search(context.Background(), &mcp.CallToolRequest{}, SearchParams{Query: "roadmap"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := &SearchResult{
+		Data: []SearchItem{
+			{ID: "obj1", SpaceId: "space1", Name: "Roadmap", Type: "Page"},
+			{ID: "obj2", SpaceId: "space1", Name: "Meeting Notes", Type: "Page"},
+		},
+		Pagination: Pagination{Total: 2, Offset: 0},
+		Stale:      testStaleness,
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected result %+v, got %+v", expected, result)
+	}
+}
+
+func TestGetObject_Offline(t *testing.T) {
+	app := newOfflineApp(t, true)
+
+	_, result, err := app.GetObject(context.Background(), &mcp.CallToolRequest{}, GetObjectParams{ObjectId: "obj1", SpaceId: "space1"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := &GetObjectResult{
+		ObjectId: "obj1",
+		SpaceId:  "space1",
+		Markdown: "# Goals\nShip the offline mode",
+		Properties: []Property{
+			{Name: "Related", Format: "objects", Value: "Meeting Notes (obj2)"},
+		},
+		Stale: testStaleness,
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected result %+v, got %+v", expected, result)
+	}
+}
+
+func TestGetObject_OfflineErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		withMirror bool
+		objectId   string
+	}{
+		{"without mirror", false, "obj1"},
+		{"object not mirrored", true, "missing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := newOfflineApp(t, tt.withMirror)
+
+			_, result, err := app.GetObject(context.Background(), &mcp.CallToolRequest{}, GetObjectParams{ObjectId: tt.objectId, SpaceId: "space1"})
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+
+			expected := "Anytype is not reachable; ask the user to make sure the Anytype desktop app is running"
+			if err.Error() != expected {
+				t.Errorf("expected error %q, got %q", expected, err.Error())
+			}
+
+			if result != nil {
+				t.Error("expected nil result on error")
+			}
+		})
+	}
+}
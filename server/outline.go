@@ -0,0 +1,185 @@
+package server
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var headingPattern = regexp.MustCompile(`^(#{1,6})[ \t]+(.+?)[ \t#]*$`)
+
+// Section is a markdown heading with the content until the next heading of the same or higher level.
+type Section struct {
+	ID       string
+	Title    string
+	Level    int
+	Start    int
+	End      int
+	Children []*Section
+}
+
+type OutlineItem struct {
+	ID       string        `json:"id" jsonschema:"the section id"`
+	Title    string        `json:"title" jsonschema:"the heading of the section"`
+	Tokens   int           `json:"tokens" jsonschema:"the estimated tokens of the section including subsections"`
+	Children []OutlineItem `json:"children,omitempty" jsonschema:"the subsections"`
+}
+
+// parseSections builds the heading tree of the markdown, headings inside fenced code blocks are ignored.
+func parseSections(markdown string) []*Section {
+	var (
+		roots  []*Section
+		stack  []*Section
+		fence  string
+		offset int
+	)
+
+	for _, line := range strings.SplitAfter(markdown, "\n") {
+		start := offset
+		offset += len(line)
+
+		trimmed := strings.TrimSpace(line)
+		if fence != "" {
+			if isClosingFence(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+
+		if marker := openingFence(trimmed); marker != "" {
+			fence = marker
+			continue
+		}
+
+		match := headingPattern.FindStringSubmatch(strings.TrimRight(line, "\r\n"))
+		if match == nil {
+			continue
+		}
+
+		section := &Section{Title: match[2], Level: len(match[1]), Start: start, End: len(markdown)}
+		for len(stack) > 0 && stack[len(stack)-1].Level >= section.Level {
+			stack[len(stack)-1].End = start
+			stack = stack[:len(stack)-1]
+		}
+
+		if len(stack) == 0 {
+			section.ID = strconv.Itoa(len(roots) + 1)
+			roots = append(roots, section)
+		} else {
+			parent := stack[len(stack)-1]
+			section.ID = parent.ID + "." + strconv.Itoa(len(parent.Children)+1)
+			parent.Children = append(parent.Children, section)
+		}
+
+		stack = append(stack, section)
+	}
+
+	return roots
+}
+
+// openingFence returns the backtick or tilde marker which opens a fenced code block, e.g. ```` or ~~~.
+func openingFence(line string) string {
+	if !strings.HasPrefix(line, "```") && !strings.HasPrefix(line, "~~~") {
+		return ""
+	}
+
+	return line[:len(line)-len(strings.TrimLeft(line, line[:1]))]
+}
+
+// isClosingFence reports whether the line closes the fence, which needs the same character
+// at least as long as the opening marker without the info string.
+func isClosingFence(line, fence string) bool {
+	marker := strings.TrimRight(line, fence[:1])
+	return marker == "" && len(line) >= len(fence)
+}
+
+// findSection looks up a section by id, e.g. 1.2, or by heading path, e.g. Setup/Install.
+// A slash in the heading is escaped by a backslash, e.g. Input\/Output.
+func findSection(sections []*Section, ref string) *Section {
+	if section := findSectionById(sections, strings.TrimSpace(ref)); section != nil {
+		return section
+	}
+
+	var found *Section
+	for _, title := range splitSectionPath(ref) {
+		found = nil
+		for _, section := range sections {
+			if strings.EqualFold(section.Title, strings.TrimSpace(title)) {
+				found = section
+				break
+			}
+		}
+
+		if found == nil {
+			return nil
+		}
+		sections = found.Children
+	}
+
+	return found
+}
+
+// splitSectionPath splits the heading path by slashes which are not escaped by a backslash.
+func splitSectionPath(ref string) []string {
+	var (
+		titles  []string
+		title   strings.Builder
+		escaped bool
+	)
+
+	for _, r := range ref {
+		switch {
+		case escaped:
+			if r != '/' && r != '\\' {
+				title.WriteRune('\\')
+			}
+			title.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '/':
+			titles = append(titles, title.String())
+			title.Reset()
+		default:
+			title.WriteRune(r)
+		}
+	}
+
+	if escaped {
+		title.WriteRune('\\')
+	}
+
+	return append(titles, title.String())
+}
+
+func findSectionById(sections []*Section, id string) *Section {
+	for _, section := range sections {
+		if section.ID == id {
+			return section
+		}
+
+		if strings.HasPrefix(id, section.ID+".") {
+			return findSectionById(section.Children, id)
+		}
+	}
+
+	return nil
+}
+
+func outline(markdown string, sections []*Section) []OutlineItem {
+	if len(sections) == 0 {
+		return nil
+	}
+
+	items := make([]OutlineItem, 0, len(sections))
+	for _, section := range sections {
+		items = append(items, OutlineItem{
+			ID:       section.ID,
+			Title:    section.Title,
+			Tokens:   estimateTokens(markdown[section.Start:section.End]),
+			Children: outline(markdown, section.Children),
+		})
+	}
+
+	return items
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+)
+
+const outlineMarkdown = "Intro text\n" +
+	"# Setup\n" +
+	"Setup text\n" +
+	"## Install\n" +
+	"Run the installer\n" +
+	"```sh\n" +
+	"# not a heading\n" +
+	"```\n" +
+	"## Configure ##\n" +
+	"Edit the file\n" +
+	"# Usage\n" +
+	"### Deep Heading\n" +
+	"Deep text\n"
+
+func TestParseSections(t *testing.T) {
+	sections := parseSections(outlineMarkdown)
+
+	result := outline(outlineMarkdown, sections)
+	expected := []OutlineItem{
+		{
+			ID:     "1",
+			Title:  "Setup",
+			Tokens: 26,
+			Children: []OutlineItem{
+				{ID: "1.1", Title: "Install", Tokens: 14},
+				{ID: "1.2", Title: "Configure", Tokens: 8},
+			},
+		},
+		{
+			ID:     "2",
+			Title:  "Usage",
+			Tokens: 9,
+			Children: []OutlineItem{
+				{ID: "2.1", Title: "Deep Heading", Tokens: 7},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected outline %+v, got %+v", expected, result)
+	}
+}
+
+func TestFindSection(t *testing.T) {
+	sections := parseSections(outlineMarkdown)
+
+	tests := []struct {
+		name            string
+		ref             string
+		expectedContent string
+	}{
+		{"by id", "1.2", "## Configure ##\nEdit the file\n"},
+		{"by heading path", "Setup/Install", "## Install\nRun the installer\n```sh\n# not a heading\n```\n"},
+		{"by case-insensitive path with spaces", "usage / deep heading", "### Deep Heading\nDeep text\n"},
+		{"by top-level heading", "Usage", "# Usage\n### Deep Heading\nDeep text\n"},
+		{"unknown id", "3", ""},
+		{"unknown path", "Setup/Uninstall", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			section := findSection(sections, tt.ref)
+			if tt.expectedContent == "" {
+				if section != nil {
+					t.Fatalf("expected no section, got %+v", section)
+				}
+				return
+			}
+
+			if section == nil {
+				t.Fatal("expected section, got nil")
+			}
+
+			if content := outlineMarkdown[section.Start:section.End]; content != tt.expectedContent {
+				t.Errorf("expected content %q, got %q", tt.expectedContent, content)
+			}
+		})
+	}
+}
+
+func TestParseSections_Fences(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected []string
+	}{
+		{
+			name:     "longer backtick fence with nested fence",
+			markdown: "````md\n```\n# Inside\n```\n````\n# After\n",
+			expected: []string{"After"},
+		},
+		{
+			name:     "tilde fence closed by longer marker",
+			markdown: "~~~\n# Inside\n~~~~\n# After\n",
+			expected: []string{"After"},
+		},
+		{
+			name:     "closing marker with info string",
+			markdown: "```\n```go\n# Inside\n```\n# After\n",
+			expected: []string{"After"},
+		},
+		{
+			name:     "different fence character",
+			markdown: "```\n~~~\n# Inside\n```\n# After\n",
+			expected: []string{"After"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var titles []string
+			for _, section := range parseSections(tt.markdown) {
+				titles = append(titles, section.Title)
+			}
+
+			if !reflect.DeepEqual(titles, tt.expected) {
+				t.Errorf("expected headings %q, got %q", tt.expected, titles)
+			}
+		})
+	}
+}
+
+func TestFindSection_EscapedSlash(t *testing.T) {
+	markdown := "# Input/Output\n## Read\\Write\nText\n"
+	sections := parseSections(markdown)
+
+	tests := []struct {
+		name          string
+		ref           string
+		expectedTitle string
+	}{
+		{"escaped slash", `Input\/Output`, "Input/Output"},
+		{"escaped slash in path", `Input\/Output/Read\Write`, "Read\\Write"},
+		{"escaped backslash", `Input\/Output/Read\\Write`, "Read\\Write"},
+		{"unescaped slash", "Input/Output", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			section := findSection(sections, tt.ref)
+			if tt.expectedTitle == "" {
+				if section != nil {
+					t.Fatalf("expected no section, got %+v", section)
+				}
+				return
+			}
+
+			if section == nil || section.Title != tt.expectedTitle {
+				t.Errorf("expected section %q, got %+v", tt.expectedTitle, section)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// answerSources is the number of search results embedded as sources to answer a question.
+	answerSources = 3
+	// reviewLimit is the maximum number of objects embedded in the daily review.
+	reviewLimit = 50
+)
+
+// SummarizeObject prompts to summarize the markdown and properties of an object.
+func (a *App) SummarizeObject(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	args := req.Params.Arguments
+	if args["objectId"] == "" {
+		return nil, errors.New("objectId is required")
+	}
+
+	_, object, err := a.GetObject(ctx, nil, GetObjectParams{ObjectId: args["objectId"], SpaceId: args["spaceId"]})
+	if err != nil {
+		return nil, err
+	}
+
+	var text strings.Builder
+	text.WriteString("Summarize the Anytype object below in a few bullet points. Keep the key facts, decisions and open questions, and do not add information which is not in the object.\n\n")
+	writeObject(&text, object)
+
+	return promptResult("Summarize an object", text.String()), nil
+}
+
+// DailyReview prompts to review the objects of a type modified today, tasks by default.
+func (a *App) DailyReview(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	args := req.Params.Arguments
+	objectType := args["type"]
+	if objectType == "" {
+		objectType = "task"
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	search := anytype.SearchInput{
+		Params: anytype.SearchParams{SpaceId: args["spaceId"], Limit: reviewLimit},
+		Body: anytype.SearchBody{
+			Types: []string{objectType},
+			Sort:  &anytype.SearchSort{PropertyKey: "last_modified_date", Direction: "desc"},
+		},
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "Review the %s objects modified today (%s) in Anytype.\n\n", objectType, today.Format(time.DateOnly))
+
+	count, truncated := 0, false
+	for object, err := range a.anytype.SearchAll(ctx, search, 0) {
+		if err != nil {
+			return nil, explainError(err, spaceNotFound(args["spaceId"]))
+		}
+
+		// Objects without last modified date are skipped instead of ending the review.
+		modified := object.LastModified()
+		if modified.IsZero() {
+			continue
+		}
+
+		if modified.Before(today) {
+			break
+		}
+
+		if count == reviewLimit {
+			truncated = true
+			break
+		}
+
+		count++
+		fmt.Fprintf(&text, "- %s (objectId: %s, spaceId: %s)\n", object.Name, object.ID, object.SpaceId)
+	}
+
+	if count == 0 {
+		fmt.Fprintf(&text, "No %s objects were modified today, tell the user there is nothing to review.\n", objectType)
+		return promptResult("Daily review", text.String()), nil
+	}
+
+	if truncated {
+		fmt.Fprintf(&text, "\nOnly the %d most recently modified objects are listed, tell the user the review is partial.\n", reviewLimit)
+	}
+
+	text.WriteString(`
+Steps:
+1. Call get-object for each object above to read its status and notes.
+2. Group the objects by status, e.g. done, in progress and blocked.
+3. Write a short review with the progress of today and suggest the next steps for the unfinished ones.
+`)
+
+	return promptResult("Daily review", text.String()), nil
+}
+
+// AnswerFromNotes prompts to answer a question from the best matching objects with citations.
+func (a *App) AnswerFromNotes(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	args := req.Params.Arguments
+	if args["question"] == "" {
+		return nil, errors.New("question is required")
+	}
+
+	query := args["query"]
+	if query == "" {
+		query = args["question"]
+	}
+
+	_, found, err := a.Search(ctx, nil, SearchParams{Query: query, SpaceId: args["spaceId"], Limit: answerSources})
+	if err != nil {
+		return nil, err
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "Answer the question using only the notes below: %s\n\n", args["question"])
+	text.WriteString("Cite the notes you use with their number, e.g. [1]. When the notes don't contain the answer, say so and suggest to call search or semantic-search with other keywords instead of guessing.\n")
+
+	sources := 0
+	for _, item := range found.Data {
+		// Skip the sources which cannot be read, e.g. deleted after indexed.
+		_, object, err := a.GetObject(ctx, nil, GetObjectParams{ObjectId: item.ID, SpaceId: item.SpaceId})
+		if err != nil {
+			continue
+		}
+
+		sources++
+		fmt.Fprintf(&text, "\n## [%d] %s\n\n", sources, item.Name)
+		writeObject(&text, object)
+	}
+
+	if sources == 0 {
+		fmt.Fprintf(&text, "\nNo notes matched %q.\n", query)
+	}
+
+	return promptResult("Answer from notes", text.String()), nil
+}
+
+// writeObject writes the properties and markdown of an object as prompt text.
+func writeObject(text *strings.Builder, object *GetObjectResult) {
+	fmt.Fprintf(text, "objectId: %s, spaceId: %s\n", object.ObjectId, object.SpaceId)
+	if object.Stale != "" {
+		fmt.Fprintf(text, "Note: %s\n", object.Stale)
+	}
+
+	for _, prop := range object.Properties {
+		fmt.Fprintf(text, "- %s: %s\n", prop.Name, prop.Value)
+	}
+
+	fmt.Fprintf(text, "\n%s\n", object.Markdown)
+
+	if object.NextCursor != "" {
+		fmt.Fprintf(text, "\nThe markdown is truncated, call get-object with cursor %q to read the rest.\n", object.NextCursor)
+	}
+}
+
+func promptResult(description, text string) *mcp.GetPromptResult {
+	return &mcp.GetPromptResult{
+		Description: description,
+		Messages: []*mcp.PromptMessage{
+			{Role: "user", Content: &mcp.TextContent{Text: text}},
+		},
+	}
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func newPromptApp(t *testing.T) *App {
+	t.Helper()
+
+	today := time.Now().Format(time.RFC3339)
+	lastWeek := time.Now().AddDate(0, 0, -7).Format(time.RFC3339)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/search", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"data":[
+			{"id":"task3","space_id":"space1","name":"Undated task"},
+			{"id":"task1","space_id":"space1","name":"Write report","properties":[{"key":"last_modified_date","format":"date","date":%q}]},
+			{"id":"task2","space_id":"space1","name":"Old task","properties":[{"key":"last_modified_date","format":"date","date":%q}]}
+		],"pagination":{"total":3}}`, today, lastWeek)
+	})
+	mux.HandleFunc("POST /v1/spaces/busy/search", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		// the undated objects are listed before the objects modified today
+		undated, total := 10, 10+reviewLimit+20
+		var data []string
+		for i := offset; i < min(offset+limit, total); i++ {
+			if i < undated {
+				data = append(data, fmt.Sprintf(`{"id":"undated%d","space_id":"busy","name":"Undated %d"}`, i, i))
+				continue
+			}
+			n := i - undated
+			data = append(data, fmt.Sprintf(`{"id":"task%d","space_id":"busy","name":"Task %d","properties":[{"key":"last_modified_date","format":"date","date":%q}]}`, n, n, today))
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[%s],"pagination":{"total":%d,"offset":%d,"has_more":%t}}`, strings.Join(data, ","), total, offset, offset+limit < total)
+	})
+	mux.HandleFunc("POST /v1/spaces/empty/search", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[],"pagination":{"total":0}}`))
+	})
+	mux.HandleFunc("GET /v1/spaces/space1/objects/task1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"object":{"id":"task1","space_id":"space1","name":"Write report","markdown":"Draft the quarterly report","properties":[{"key":"status","name":"Status","format":"text","text":"In Progress"}]}}`))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"not_found","message":"object not found","object":"error","status":404}`))
+	})
+
+	api := httptest.NewServer(mux)
+	t.Cleanup(api.Close)
+
+	return New(anytype.New("test-api-key", anytype.WithApiServer(api.URL)))
+}
+
+func TestPrompts(t *testing.T) {
+	app := newPromptApp(t)
+
+	tests := []struct {
+		name     string
+		handler  mcp.PromptHandler
+		args     map[string]string
+		contains []string
+		excludes []string
+		wantErr  bool
+	}{
+		{
+			name:     "summarize object",
+			handler:  app.SummarizeObject,
+			args:     map[string]string{"objectId": "task1", "spaceId": "space1"},
+			contains: []string{"Summarize", "- Status: In Progress", "Draft the quarterly report"},
+		},
+		{
+			name:    "summarize missing object",
+			handler: app.SummarizeObject,
+			args:    map[string]string{"objectId": "missing", "spaceId": "space1"},
+			wantErr: true,
+		},
+		{
+			name:    "summarize without object id",
+			handler: app.SummarizeObject,
+			wantErr: true,
+		},
+		{
+			name:     "daily review",
+			handler:  app.DailyReview,
+			contains: []string{"task objects modified today", "- Write report (objectId: task1, spaceId: space1)", "get-object"},
+			excludes: []string{"Old task", "Undated task", "partial"},
+		},
+		{
+			name:     "daily review truncated after undated objects",
+			handler:  app.DailyReview,
+			args:     map[string]string{"spaceId": "busy"},
+			contains: []string{"Task 49 (objectId: task49", "Only the 50 most recently modified objects are listed"},
+			excludes: []string{"Task 50 ", "Undated"},
+		},
+		{
+			name:    "daily review in missing space",
+			handler: app.DailyReview,
+			args:    map[string]string{"spaceId": "missing"},
+			wantErr: true,
+		},
+		{
+			name:     "daily review without changes",
+			handler:  app.DailyReview,
+			args:     map[string]string{"spaceId": "empty"},
+			contains: []string{"nothing to review"},
+		},
+		{
+			name:     "answer from notes",
+			handler:  app.AnswerFromNotes,
+			args:     map[string]string{"question": "What is the report about?", "query": "report"},
+			contains: []string{"What is the report about?", "[1] Write report", "Draft the quarterly report", "Cite"},
+		},
+		{
+			name:    "answer without question",
+			handler: app.AnswerFromNotes,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := tt.handler(context.Background(), &mcp.GetPromptRequest{Params: &mcp.GetPromptParams{Arguments: tt.args}})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if len(result.Messages) != 1 {
+				t.Fatalf("expected 1 message, got %d", len(result.Messages))
+			}
+
+			text := result.Messages[0].Content.(*mcp.TextContent).Text
+			for _, s := range tt.contains {
+				if !strings.Contains(text, s) {
+					t.Errorf("expected prompt to contain %q, got %q", s, text)
+				}
+			}
+			for _, s := range tt.excludes {
+				if strings.Contains(text, s) {
+					t.Errorf("expected prompt not to contain %q, got %q", s, text)
+				}
+			}
+		})
+	}
+}
@@ -1,7 +1,349 @@
 package server
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+)
+
 type Property struct {
 	Name   string `json:"name" jsonschema:"the name of the property"`
 	Format string `json:"format" jsonschema:"the format of the property"`
 	Value  string `json:"value" jsonschema:"the value of the property"`
 }
+
+// propertyValue renders the property value into a compact string, the names
+// map is used to resolve the name of linked objects and files. It returns false
+// when the property has no value to render.
+func propertyValue(prop anytype.Property, names map[string]string) (string, bool) {
+	switch prop.Format {
+	case "text":
+		return prop.Text, true
+	case "date":
+		return prop.Date, true
+	case "url":
+		return prop.Url, prop.Url != ""
+	case "email":
+		return prop.Email, prop.Email != ""
+	case "phone":
+		return prop.Phone, prop.Phone != ""
+	case "number":
+		if prop.Number == nil {
+			return "", false
+		}
+		return strconv.FormatFloat(*prop.Number, 'f', -1, 64), true
+	case "checkbox":
+		return strconv.FormatBool(prop.Checkbox), true
+	case "select":
+		if prop.Select == nil {
+			return "", false
+		}
+		return prop.Select.Name, prop.Select.Name != ""
+	case "multi_select":
+		tags := make([]string, 0, len(prop.MultiSelect))
+		for _, tag := range prop.MultiSelect {
+			tags = append(tags, tag.Name)
+		}
+		return strings.Join(tags, ", "), len(tags) > 0
+	case "files":
+		return linkedValue(prop.Files, names), len(prop.Files) > 0
+	case "objects":
+		return linkedValue(prop.Objects, names), len(prop.Objects) > 0
+	}
+
+	return "", false
+}
+
+func linkedValue(ids []string, names map[string]string) string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		name, ok := names[id]
+		if !ok || name == "" {
+			values = append(values, id)
+			continue
+		}
+
+		values = append(values, name+" ("+id+")")
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// maxLinkedNames is the maximum number of linked objects fetched to resolve their names,
+// the objects beyond it are rendered by id only.
+const maxLinkedNames = 20
+
+// linkedNames fetches the names of the objects, files and members linked by properties.
+// Objects that cannot be fetched are skipped and rendered by id only, the names are from the mirror when offline.
+func (a *App) linkedNames(ctx context.Context, spaceId string, props []anytype.Property, offline bool) map[string]string {
+	var members map[string]string
+	membersLoaded := false
+
+	var links []objectLink
+	names := make(map[string]string)
+	for _, prop := range props {
+		var ids []string
+		switch prop.Format {
+		case "files":
+			ids = prop.Files
+		case "objects":
+			ids = prop.Objects
+		}
+
+		for _, id := range ids {
+			if _, ok := names[id]; ok {
+				continue
+			}
+			names[id] = ""
+
+			if isMemberId(id) && !offline {
+				if !membersLoaded {
+					members, membersLoaded = a.memberNames(ctx, spaceId), true
+				}
+				names[id] = members[id]
+				continue
+			}
+
+			if offline {
+				if object, _, ok := a.mirror.Object(spaceId, id); ok {
+					names[id] = object.Name
+				}
+				continue
+			}
+
+			if len(links) < maxLinkedNames {
+				links = append(links, objectLink{spaceId: spaceId, objectId: id})
+			}
+		}
+	}
+
+	for i, object := range a.fetchLinked(ctx, links) {
+		if object != nil {
+			names[links[i].objectId] = object.Name
+		}
+	}
+
+	return names
+}
+
+// propertyInputs validates the property name to value map against the property
+// definitions of the space and converts it into values accepted by Anytype.
+// Properties can be referenced by their name or key.
+func (a *App) propertyInputs(ctx context.Context, spaceId string, values map[string]any) ([]anytype.PropertyValue, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	definitions, err := a.spaceProperties(ctx, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	inputs := make([]anytype.PropertyValue, 0, len(names))
+	for _, name := range names {
+		idx := findProperty(definitions, name)
+		if idx < 0 {
+			return nil, fmt.Errorf("unknown property %q, use describe-space to list the properties", name)
+		}
+
+		input, err := a.propertyInput(ctx, spaceId, definitions[idx], values[name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of property %q: %w", name, err)
+		}
+
+		inputs = append(inputs, input)
+	}
+
+	return inputs, nil
+}
+
+// findProperty returns the index of the property referenced by its id, key or name, or -1 when not found.
+func findProperty(definitions []anytype.PropertyDefinition, name string) int {
+	return slices.IndexFunc(definitions, func(def anytype.PropertyDefinition) bool {
+		return def.ID == name || def.Key == name || strings.EqualFold(def.Name, name)
+	})
+}
+
+// selectProperty resolves the select or multi_select property which owns tags.
+func (a *App) selectProperty(ctx context.Context, spaceId, name string) (anytype.PropertyDefinition, error) {
+	definitions, err := a.spaceProperties(ctx, spaceId)
+	if err != nil {
+		return anytype.PropertyDefinition{}, err
+	}
+
+	idx := findProperty(definitions, name)
+	if idx < 0 {
+		return anytype.PropertyDefinition{}, fmt.Errorf("unknown property %q, use describe-space to list the properties", name)
+	}
+
+	def := definitions[idx]
+	if def.Format != "select" && def.Format != "multi_select" {
+		return anytype.PropertyDefinition{}, fmt.Errorf("property %q is %s, only select and multi_select properties have tags", name, def.Format)
+	}
+
+	return def, nil
+}
+
+// validateColor checks the tag color, empty color is allowed to keep the default.
+func validateColor(color string) error {
+	if color == "" || slices.Contains(anytype.TagColors, color) {
+		return nil
+	}
+
+	return fmt.Errorf("unknown color %q, expected one of: %s", color, strings.Join(anytype.TagColors, ", "))
+}
+
+func (a *App) propertyInput(ctx context.Context, spaceId string, def anytype.PropertyDefinition, raw any) (anytype.PropertyValue, error) {
+	input := anytype.PropertyValue{Key: def.Key}
+
+	switch def.Format {
+	case "text", "url", "email", "phone":
+		value, ok := raw.(string)
+		if !ok {
+			return input, errors.New("expected a string")
+		}
+
+		switch def.Format {
+		case "text":
+			input.Text = &value
+		case "url":
+			input.Url = &value
+		case "email":
+			input.Email = &value
+		case "phone":
+			input.Phone = &value
+		}
+	case "date":
+		value, ok := raw.(string)
+		if !ok {
+			return input, errors.New("expected a date string")
+		}
+
+		date, err := parseDate(value)
+		if err != nil {
+			return input, err
+		}
+		input.Date = &date
+	case "number":
+		value, ok := raw.(float64)
+		if !ok {
+			return input, errors.New("expected a number")
+		}
+		input.Number = &value
+	case "checkbox":
+		value, ok := raw.(bool)
+		if !ok {
+			return input, errors.New("expected true or false")
+		}
+		input.Checkbox = &value
+	case "select":
+		value, ok := raw.(string)
+		if !ok {
+			return input, errors.New("expected a tag name")
+		}
+
+		ids, err := a.tagIds(ctx, spaceId, def, []string{value})
+		if err != nil {
+			return input, err
+		}
+		input.Select = &ids[0]
+	case "multi_select":
+		values, err := stringList(raw)
+		if err != nil {
+			return input, err
+		}
+
+		ids, err := a.tagIds(ctx, spaceId, def, values)
+		if err != nil {
+			return input, err
+		}
+		input.MultiSelect = &ids
+	case "files", "objects":
+		values, err := stringList(raw)
+		if err != nil {
+			return input, err
+		}
+
+		if def.Format == "files" {
+			input.Files = &values
+		} else {
+			input.Objects = &values
+		}
+	default:
+		return input, fmt.Errorf("format %s is not supported", def.Format)
+	}
+
+	return input, nil
+}
+
+// tagIds resolves tag names of a select property into tag ids.
+func (a *App) tagIds(ctx context.Context, spaceId string, def anytype.PropertyDefinition, names []string) ([]string, error) {
+	tags, err := a.propertyTags(ctx, spaceId, def.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		idx := slices.IndexFunc(tags, func(tag anytype.Tag) bool {
+			return tag.ID == name || strings.EqualFold(tag.Name, name)
+		})
+		if idx < 0 {
+			valid := make([]string, 0, len(tags))
+			for _, tag := range tags {
+				valid = append(valid, tag.Name)
+			}
+
+			return nil, fmt.Errorf("unknown tag %q, expected one of: %s", name, strings.Join(valid, ", "))
+		}
+
+		ids = append(ids, tags[idx].ID)
+	}
+
+	return ids, nil
+}
+
+func stringList(raw any) ([]string, error) {
+	switch value := raw.(type) {
+	case string:
+		return []string{value}, nil
+	case []any:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			str, ok := item.(string)
+			if !ok {
+				return nil, errors.New("expected a list of strings")
+			}
+			values = append(values, str)
+		}
+		return values, nil
+	}
+
+	return nil, errors.New("expected a list of strings")
+}
+
+// parseDate accepts a date or RFC 3339 date time and normalizes it to RFC 3339.
+func parseDate(value string) (string, error) {
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		return date.Format(time.RFC3339), nil
+	}
+
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return "", errors.New("expected a date in YYYY-MM-DD or RFC 3339 format")
+	}
+
+	return date.Format(time.RFC3339), nil
+}
@@ -0,0 +1,230 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+)
+
+func TestPropertyValue(t *testing.T) {
+	number := 42.5
+	zero := 0.0
+	names := map[string]string{
+		"obj1":  "Project Alpha",
+		"file1": "report.pdf",
+	}
+
+	tests := []struct {
+		name          string
+		property      anytype.Property
+		expectedValue string
+		expectedOk    bool
+	}{
+		{"text", anytype.Property{Format: "text", Text: "hello"}, "hello", true},
+		{"empty text", anytype.Property{Format: "text"}, "", true},
+		{"empty date", anytype.Property{Format: "date"}, "", true},
+		{"date", anytype.Property{Format: "date", Date: "2024-01-15T00:00:00Z"}, "2024-01-15T00:00:00Z", true},
+		{"number", anytype.Property{Format: "number", Number: &number}, "42.5", true},
+		{"zero number", anytype.Property{Format: "number", Number: &zero}, "0", true},
+		{"empty number", anytype.Property{Format: "number"}, "", false},
+		{"checked checkbox", anytype.Property{Format: "checkbox", Checkbox: true}, "true", true},
+		{"unchecked checkbox", anytype.Property{Format: "checkbox"}, "false", true},
+		{"url", anytype.Property{Format: "url", Url: "https://anytype.io"}, "https://anytype.io", true},
+		{"email", anytype.Property{Format: "email", Email: "user@example.com"}, "user@example.com", true},
+		{"phone", anytype.Property{Format: "phone", Phone: "+1 555 0100"}, "+1 555 0100", true},
+		{"select", anytype.Property{Format: "select", Select: &anytype.Tag{ID: "tag1", Name: "Done"}}, "Done", true},
+		{"empty select", anytype.Property{Format: "select"}, "", false},
+		{"multi select", anytype.Property{Format: "multi_select", MultiSelect: []anytype.Tag{{Name: "work"}, {Name: "urgent"}}}, "work, urgent", true},
+		{"empty multi select", anytype.Property{Format: "multi_select"}, "", false},
+		{"files", anytype.Property{Format: "files", Files: []string{"file1"}}, "report.pdf (file1)", true},
+		{"objects", anytype.Property{Format: "objects", Objects: []string{"obj1", "obj2"}}, "Project Alpha (obj1), obj2", true},
+		{"empty objects", anytype.Property{Format: "objects"}, "", false},
+		{"unknown format", anytype.Property{Format: "unknown", Text: "ignored"}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			value, ok := propertyValue(tt.property, names)
+			if ok != tt.expectedOk {
+				t.Errorf("expected ok %v, got %v", tt.expectedOk, ok)
+			}
+
+			if value != tt.expectedValue {
+				t.Errorf("expected value %q, got %q", tt.expectedValue, value)
+			}
+		})
+	}
+}
+
+func TestLinkedNames_Limit(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		id := r.URL.Path[strings.LastIndexByte(r.URL.Path, '/')+1:]
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&anytype.GetObjectOutput{Object: anytype.Object{ID: id, Name: "Name of " + id}})
+	}))
+	defer server.Close()
+
+	app := New(anytype.New("test-api-key", anytype.WithApiServer(server.URL)))
+
+	ids := make([]string, maxLinkedNames+5)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("obj%d", i)
+	}
+
+	names := app.linkedNames(context.Background(), "space1", []anytype.Property{{Format: "objects", Objects: ids}}, false)
+
+	if int(requests.Load()) != maxLinkedNames {
+		t.Errorf("expected %d requests, got %d", maxLinkedNames, requests.Load())
+	}
+
+	if names["obj0"] != "Name of obj0" {
+		t.Errorf("expected the first object to be resolved, got %q", names["obj0"])
+	}
+
+	last := ids[len(ids)-1]
+	if name, ok := names[last]; !ok || name != "" {
+		t.Errorf("expected the object beyond the limit to be rendered by id, got %q", name)
+	}
+}
+
+func TestPropertyInputs(t *testing.T) {
+	text := "Weekly sync"
+	number := 3.0
+	checked := true
+	date := "2024-01-15T00:00:00Z"
+	status := "tag2"
+
+	responses := map[string]any{
+		"/v1/spaces/space1/properties": &anytype.ListPropertiesOutput{
+			Data: []anytype.PropertyDefinition{
+				{ID: "p1", Key: "description", Name: "Description", Format: "text"},
+				{ID: "p2", Key: "estimate", Name: "Estimate", Format: "number"},
+				{ID: "p3", Key: "done", Name: "Done", Format: "checkbox"},
+				{ID: "p4", Key: "due_date", Name: "Due Date", Format: "date"},
+				{ID: "p5", Key: "status", Name: "Status", Format: "select"},
+				{ID: "p6", Key: "tag", Name: "Tag", Format: "multi_select"},
+				{ID: "p7", Key: "links", Name: "Links", Format: "objects"},
+			},
+		},
+		"/v1/spaces/space1/properties/p5/tags": &anytype.ListTagsOutput{
+			Data: []anytype.Tag{{ID: "tag1", Name: "To Do"}, {ID: "tag2", Name: "Done"}},
+		},
+		"/v1/spaces/space1/properties/p6/tags": &anytype.ListTagsOutput{
+			Data: []anytype.Tag{{ID: "tag3", Name: "work"}, {ID: "tag4", Name: "urgent"}},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(responses[r.URL.Path])
+	}))
+	t.Cleanup(server.Close)
+
+	app := New(anytype.New("test-api-key", anytype.WithApiServer(server.URL)))
+
+	tests := []struct {
+		name          string
+		values        map[string]any
+		expected      []anytype.PropertyValue
+		expectedError string
+	}{
+		{
+			name:     "no properties",
+			values:   nil,
+			expected: nil,
+		},
+		{
+			name: "scalar values by name and key",
+			values: map[string]any{
+				"Description": "Weekly sync",
+				"estimate":    3.0,
+				"done":        true,
+				"Due Date":    "2024-01-15",
+			},
+			expected: []anytype.PropertyValue{
+				{Key: "description", Text: &text},
+				{Key: "due_date", Date: &date},
+				{Key: "done", Checkbox: &checked},
+				{Key: "estimate", Number: &number},
+			},
+		},
+		{
+			name: "tags resolved by name",
+			values: map[string]any{
+				"Status": "done",
+				"Tag":    []any{"work", "urgent"},
+			},
+			expected: []anytype.PropertyValue{
+				{Key: "status", Select: &status},
+				{Key: "tag", MultiSelect: &[]string{"tag3", "tag4"}},
+			},
+		},
+		{
+			name:     "objects accept a single id",
+			values:   map[string]any{"Links": "obj1"},
+			expected: []anytype.PropertyValue{{Key: "links", Objects: &[]string{"obj1"}}},
+		},
+		{
+			name:     "empty list clears objects",
+			values:   map[string]any{"Links": []any{}},
+			expected: []anytype.PropertyValue{{Key: "links", Objects: &[]string{}}},
+		},
+		{
+			name:          "unknown property",
+			values:        map[string]any{"Priority": "high"},
+			expectedError: `unknown property "Priority", use describe-space to list the properties`,
+		},
+		{
+			name:          "wrong value type",
+			values:        map[string]any{"Estimate": "three"},
+			expectedError: `invalid value of property "Estimate": expected a number`,
+		},
+		{
+			name:          "unknown tag",
+			values:        map[string]any{"Status": "Blocked"},
+			expectedError: `invalid value of property "Status": unknown tag "Blocked", expected one of: To Do, Done`,
+		},
+		{
+			name:          "invalid date",
+			values:        map[string]any{"Due Date": "tomorrow"},
+			expectedError: `invalid value of property "Due Date": expected a date in YYYY-MM-DD or RFC 3339 format`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			inputs, err := app.propertyInputs(context.Background(), "space1", tt.values)
+
+			if tt.expectedError != "" {
+				if err == nil || err.Error() != tt.expectedError {
+					t.Fatalf("expected error %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(inputs, tt.expected) {
+				t.Errorf("expected inputs %+v, got %+v", tt.expected, inputs)
+			}
+		})
+	}
+}
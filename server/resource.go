@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// ObjectURITemplate is the resource template of objects.
+	ObjectURITemplate = "anytype://{spaceId}/{objectId}"
+	// recentObjectsPerSpace is the number of recently modified objects listed as resources for each space.
+	recentObjectsPerSpace = 20
+)
+
+func objectURI(spaceId, objectId string) string {
+	return "anytype://" + spaceId + "/" + objectId
+}
+
+func parseObjectURI(uri string) (spaceId, objectId string, ok bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "anytype" || u.Host == "" {
+		return "", "", false
+	}
+
+	objectId = strings.TrimPrefix(u.Path, "/")
+	if objectId == "" || strings.Contains(objectId, "/") {
+		return "", "", false
+	}
+
+	return u.Host, objectId, true
+}
+
+// ReadObject returns the markdown of the object resource.
+func (a *App) ReadObject(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	uri := req.Params.URI
+
+	spaceId, objectId, ok := parseObjectURI(uri)
+	if !ok {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+
+	object, _, err := a.fetchObject(ctx, spaceId, objectId)
+	if errors.Is(err, anytype.ErrNotFound) {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+	if err != nil {
+		return nil, explainError(err, "")
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{URI: uri, MIMEType: "text/markdown", Text: object.Markdown},
+		},
+	}, nil
+}
+
+// ListRecentObjects lists the recently modified objects of each space as resources.
+func (a *App) ListRecentObjects(ctx context.Context, req *mcp.ListResourcesRequest) (*mcp.ListResourcesResult, error) {
+	resources := []*mcp.Resource{}
+
+	for space, err := range a.anytype.ListSpacesAll(ctx, anytype.ListSpacesInput{}, 0) {
+		if err != nil {
+			return nil, explainError(err, "")
+		}
+
+		search := anytype.SearchInput{
+			Params: anytype.SearchParams{SpaceId: space.ID, Limit: recentObjectsPerSpace},
+			Body: anytype.SearchBody{
+				Sort: &anytype.SearchSort{PropertyKey: "last_modified_date", Direction: "desc"},
+			},
+		}
+
+		for object, err := range a.anytype.SearchAll(ctx, search, recentObjectsPerSpace) {
+			if err != nil {
+				return nil, explainError(err, "")
+			}
+
+			resources = append(resources, &mcp.Resource{
+				URI:         objectURI(space.ID, object.ID),
+				Name:        object.Name,
+				Description: object.Type.Name + " in " + space.Name,
+				MIMEType:    "text/markdown",
+			})
+		}
+	}
+
+	return &mcp.ListResourcesResult{Resources: resources}, nil
+}
+
+// ResourceListMiddleware serves resources/list by the recently modified objects since they cannot be registered statically.
+func (a *App) ResourceListMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		listReq, ok := req.(*mcp.ListResourcesRequest)
+		if method != "resources/list" || !ok {
+			return next(ctx, method, req)
+		}
+
+		return a.ListRecentObjects(ctx, listReq)
+	}
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func newResourceSession(t *testing.T, app *App) *mcp.ClientSession {
+	t.Helper()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "anytype", Version: "test"}, nil)
+	server.AddResourceTemplate(&mcp.ResourceTemplate{Name: "object", URITemplate: ObjectURITemplate, MIMEType: "text/markdown"}, app.ReadObject)
+	server.AddReceivingMiddleware(app.ResourceListMiddleware)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(context.Background(), serverTransport, nil); err != nil {
+		t.Fatalf("failed to connect server: %v", err)
+	}
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "test"}, nil)
+	session, err := client.Connect(context.Background(), clientTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect client: %v", err)
+	}
+	t.Cleanup(func() { _ = session.Close() })
+
+	return session
+}
+
+func newResourceApp(t *testing.T) *App {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/spaces", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"id":"space1","name":"Work"}],"pagination":{"total":1}}`))
+	})
+	mux.HandleFunc("POST /v1/spaces/space1/search", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"id":"obj1","name":"Roadmap","type":{"name":"Page"}}],"pagination":{"total":1}}`))
+	})
+	mux.HandleFunc("GET /v1/spaces/space1/objects/obj1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"object":{"id":"obj1","space_id":"space1","name":"Roadmap","markdown":"# Goals"}}`))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"not_found","message":"object not found","object":"error","status":404}`))
+	})
+
+	api := httptest.NewServer(mux)
+	t.Cleanup(api.Close)
+
+	return New(anytype.New("test-api-key", anytype.WithApiServer(api.URL)))
+}
+
+func TestListRecentObjects(t *testing.T) {
+	session := newResourceSession(t, newResourceApp(t))
+
+	result, err := session.ListResources(context.Background(), &mcp.ListResourcesParams{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := []*mcp.Resource{
+		{URI: "anytype://space1/obj1", Name: "Roadmap", Description: "Page in Work", MIMEType: "text/markdown"},
+	}
+	if !reflect.DeepEqual(result.Resources, expected) {
+		t.Errorf("expected resources %+v, got %+v", expected, result.Resources)
+	}
+}
+
+func TestReadObject(t *testing.T) {
+	session := newResourceSession(t, newResourceApp(t))
+
+	tests := []struct {
+		name     string
+		uri      string
+		expected string
+		wantErr  bool
+	}{
+		{name: "object markdown", uri: "anytype://space1/obj1", expected: "# Goals"},
+		{name: "object not found", uri: "anytype://space1/missing", wantErr: true},
+		{name: "invalid uri", uri: "anytype://space1/obj1/extra", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := session.ReadResource(context.Background(), &mcp.ReadResourceParams{URI: tt.uri})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if len(result.Contents) != 1 || result.Contents[0].Text != tt.expected || result.Contents[0].URI != tt.uri {
+				t.Errorf("expected markdown %q, got %+v", tt.expected, result.Contents)
+			}
+		})
+	}
+}
+
+func TestParseObjectURI(t *testing.T) {
+	tests := []struct {
+		uri      string
+		spaceId  string
+		objectId string
+		ok       bool
+	}{
+		{"anytype://space1/obj1", "space1", "obj1", true},
+		{"anytype://space1/", "", "", false},
+		{"anytype:///obj1", "", "", false},
+		{"https://space1/obj1", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			t.Parallel()
+
+			spaceId, objectId, ok := parseObjectURI(tt.uri)
+			if spaceId != tt.spaceId || objectId != tt.objectId || ok != tt.ok {
+				t.Errorf("expected (%q, %q, %v), got (%q, %q, %v)", tt.spaceId, tt.objectId, tt.ok, spaceId, objectId, ok)
+			}
+		})
+	}
+}
@@ -7,9 +7,18 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
+type SearchSort struct {
+	Property  string `json:"property" jsonschema:"the property key to sort by, e.g. last_modified_date"`
+	Direction string `json:"direction,omitempty" jsonschema:"the sort direction, asc or desc"`
+}
+
 type SearchParams struct {
-	Query  string `json:"query" jsonschema:"the search query"`
-	Offset int    `json:"offset" jsonschema:"the offset for pagination"`
+	Query   string      `json:"query" jsonschema:"the search query"`
+	Offset  int         `json:"offset" jsonschema:"the offset for pagination"`
+	SpaceId string      `json:"spaceId,omitempty" jsonschema:"limit the search to the space"`
+	Types   []string    `json:"types,omitempty" jsonschema:"limit the search to the object type keys, e.g. page or task"`
+	Sort    *SearchSort `json:"sort,omitempty" jsonschema:"the sort order of the results"`
+	Limit   int         `json:"limit,omitempty" jsonschema:"the maximum number of results"`
 }
 
 type SearchItem struct {
@@ -22,18 +31,36 @@ type SearchItem struct {
 type SearchResult struct {
 	Data       []SearchItem `json:"data" jsonschema:"the objects returned by the search"`
 	Pagination Pagination   `json:"pagination" jsonschema:"the pagination info"`
+	Stale      string       `json:"stale,omitempty" jsonschema:"set when the results are from the offline mirror"`
 }
 
 func (a *App) Search(ctx context.Context, req *mcp.CallToolRequest, params SearchParams) (*mcp.CallToolResult, *SearchResult, error) {
+	var sort *anytype.SearchSort
+	if params.Sort != nil {
+		sort = &anytype.SearchSort{
+			PropertyKey: params.Sort.Property,
+			Direction:   params.Sort.Direction,
+		}
+	}
+
 	res, err := a.anytype.Search(ctx, anytype.SearchInput{
 		Params: anytype.SearchParams{
-			Offset: params.Offset,
+			SpaceId: params.SpaceId,
+			Offset:  params.Offset,
+			Limit:   params.Limit,
 		},
 		Body: anytype.SearchBody{
 			Query: params.Query,
+			Types: params.Types,
+			Sort:  sort,
 		},
 	})
+	if a.offline(err) {
+		return nil, a.searchMirror(params), nil
+	}
+
 	if err != nil {
+		err = explainError(err, spaceNotFound(params.SpaceId))
 		return &mcp.CallToolResult{
 			IsError: true,
 			Content: []mcp.Content{
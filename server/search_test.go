@@ -266,7 +266,31 @@ func TestSearch_ErrorHandling(t *testing.T) {
 				Object:  "auth",
 				Status:  401,
 			},
-			expectedError: "The object auth returned an error: Invalid API key (code: unauthorized, status: 401)",
+			expectedError: "Anytype rejected the API key; ask the user to pair again with the auth command or update ANYTYPE_API_KEY",
+		},
+		{
+			name:       "space not found",
+			params:     SearchParams{Query: "test", SpaceId: "space404"},
+			statusCode: http.StatusNotFound,
+			mockError: &anytype.Error{
+				Code:    "not_found",
+				Message: "Space not found",
+				Object:  "space",
+				Status:  404,
+			},
+			expectedError: "space space404 not found; use list-spaces to find the space id",
+		},
+		{
+			name:       "not found without space",
+			params:     SearchParams{Query: "test"},
+			statusCode: http.StatusNotFound,
+			mockError: &anytype.Error{
+				Code:    "not_found",
+				Message: "Not found",
+				Object:  "search",
+				Status:  404,
+			},
+			expectedError: "The object search returned an error: Not found (code: not_found, status: 404)",
 		},
 	}
 
@@ -611,6 +635,89 @@ func TestSearch_RequestValidation(t *testing.T) {
 	}
 }
 
+func TestSearch_Filters(t *testing.T) {
+	tests := []struct {
+		name         string
+		params       SearchParams
+		expectedPath string
+		expectedRaw  string
+		expectedBody anytype.SearchBody
+	}{
+		{
+			name:         "global search without filters",
+			params:       SearchParams{Query: "notes"},
+			expectedPath: "/v1/search",
+			expectedRaw:  "offset=0",
+			expectedBody: anytype.SearchBody{Query: "notes"},
+		},
+		{
+			name:         "space scoped search",
+			params:       SearchParams{Query: "notes", SpaceId: "space1"},
+			expectedPath: "/v1/spaces/space1/search",
+			expectedRaw:  "offset=0",
+			expectedBody: anytype.SearchBody{Query: "notes"},
+		},
+		{
+			name: "search with types, sort and limit",
+			params: SearchParams{
+				Query:   "",
+				Offset:  5,
+				SpaceId: "space2",
+				Types:   []string{"page", "task"},
+				Sort:    &SearchSort{Property: "last_modified_date", Direction: "desc"},
+				Limit:   10,
+			},
+			expectedPath: "/v1/spaces/space2/search",
+			expectedRaw:  "limit=10&offset=5",
+			expectedBody: anytype.SearchBody{
+				Query: "",
+				Types: []string{"page", "task"},
+				Sort:  &anytype.SearchSort{PropertyKey: "last_modified_date", Direction: "desc"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.expectedPath {
+					t.Errorf("expected path %s, got %s", tt.expectedPath, r.URL.Path)
+				}
+
+				if r.URL.RawQuery != tt.expectedRaw {
+					t.Errorf("expected query params %s, got %s", tt.expectedRaw, r.URL.RawQuery)
+				}
+
+				var body anytype.SearchBody
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatalf("failed to decode request body: %v", err)
+				}
+
+				if !reflect.DeepEqual(body, tt.expectedBody) {
+					t.Errorf("expected body %+v, got %+v", tt.expectedBody, body)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(&anytype.SearchOutput{})
+			}))
+			defer server.Close()
+
+			client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+			app := New(client)
+			req := &mcp.CallToolRequest{}
+
+			_, _, err := app.Search(context.Background(), req, tt.params)
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
 func TestSearch_ContextCancellation(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Simulate a slow response that would be cancelled
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/semantic"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var ErrSemanticIndexNotBuilt = errors.New("the semantic index is not built yet; use search instead or try again later")
+
+type SemanticSearchParams struct {
+	Query   string `json:"query" jsonschema:"the natural language description of the content to find"`
+	SpaceId string `json:"spaceId,omitempty" jsonschema:"limit the search to the space"`
+	Limit   int    `json:"limit,omitempty" jsonschema:"the maximum number of results, defaults to 10"`
+}
+
+type SemanticSearchItem struct {
+	ID      string  `json:"id" jsonschema:"the id of the object"`
+	SpaceId string  `json:"space_id" jsonschema:"the space id of the object"`
+	Name    string  `json:"name" jsonschema:"the name of the object"`
+	Snippet string  `json:"snippet" jsonschema:"the most similar part of the object markdown"`
+	Score   float64 `json:"score" jsonschema:"the similarity from 0 to 1"`
+}
+
+type SemanticSearchResult struct {
+	Data []SemanticSearchItem `json:"data" jsonschema:"the objects ranked by similarity"`
+}
+
+// WithSemanticIndex enables semantic search, the index is only queried and is built by the background refresh or the sync command.
+func WithSemanticIndex(index *semantic.Index) AppOption {
+	return func(a *App) {
+		a.semantic = index
+	}
+}
+
+func (a *App) SemanticSearch(ctx context.Context, req *mcp.CallToolRequest, params SemanticSearchParams) (*mcp.CallToolResult, *SemanticSearchResult, error) {
+	results, err := a.semanticSearch(ctx, params)
+	if err != nil {
+		err = explainError(err, "")
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	items := make([]SemanticSearchItem, len(results))
+	for i, result := range results {
+		items[i] = SemanticSearchItem{
+			ID:      result.ObjectId,
+			SpaceId: result.SpaceId,
+			Name:    result.Name,
+			Snippet: result.Snippet,
+			Score:   result.Score,
+		}
+	}
+
+	return nil, &SemanticSearchResult{Data: items}, nil
+}
+
+func (a *App) semanticSearch(ctx context.Context, params SemanticSearchParams) ([]semantic.Result, error) {
+	if a.semantic == nil {
+		return nil, errors.New("semantic search is not enabled")
+	}
+
+	if err := a.semantic.Reload(); err != nil {
+		return nil, err
+	}
+
+	if a.semantic.RefreshedAt().IsZero() {
+		return nil, ErrSemanticIndexNotBuilt
+	}
+
+	return a.semantic.Search(ctx, params.Query, params.SpaceId, params.Limit)
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/internal/anytypetest"
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/elct9620/anytype-mcp-lite/pkg/mirror"
+	"github.com/elct9620/anytype-mcp-lite/pkg/semantic"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestSemanticSearch(t *testing.T) {
+	fake := &anytypetest.Server{}
+	fake.Add("obj1", "Roadmap", "Planning the quarterly milestones", time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC))
+	fake.Add("obj2", "Recipes", "Chocolate cake with strawberries", time.Date(2025, 5, 20, 13, 0, 0, 0, time.UTC))
+
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	store, err := mirror.Open(filepath.Join(t.TempDir(), "mirror.json"))
+	if err != nil {
+		t.Fatalf("failed to open mirror: %v", err)
+	}
+
+	if _, err := mirror.Sync(context.Background(), client, store, false); err != nil {
+		t.Fatalf("failed to sync mirror: %v", err)
+	}
+
+	index, err := semantic.Open("", semantic.NewHashEmbedder(0))
+	if err != nil {
+		t.Fatalf("failed to open index: %v", err)
+	}
+
+	if _, err := index.Refresh(context.Background(), store, false); err != nil {
+		t.Fatalf("failed to refresh index: %v", err)
+	}
+
+	app := New(client, WithSemanticIndex(index))
+
+	_, result, err := app.SemanticSearch(context.Background(), &mcp.CallToolRequest{}, SemanticSearchParams{Query: "plan milestones for the quarter", Limit: 1})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(result.Data) != 1 {
+		t.Fatalf("expected 1 result, got %+v", result.Data)
+	}
+
+	item := result.Data[0]
+	if item.ID != "obj1" || item.SpaceId != "space1" || item.Name != "Roadmap" || item.Snippet != "Planning the quarterly milestones" {
+		t.Errorf("expected Roadmap with its snippet, got %+v", item)
+	}
+
+	if item.Score <= 0 || item.Score > 1 {
+		t.Errorf("expected score between 0 and 1, got %f", item.Score)
+	}
+}
+
+func TestSemanticSearch_Errors(t *testing.T) {
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	index, err := semantic.Open("", semantic.NewHashEmbedder(0))
+	if err != nil {
+		t.Fatalf("failed to open index: %v", err)
+	}
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(unreachable.URL))
+
+	tests := []struct {
+		name          string
+		app           *App
+		expectedError string
+	}{
+		{"not enabled", New(client), "semantic search is not enabled"},
+		{"index never built", New(client, WithSemanticIndex(index)), ErrSemanticIndexNotBuilt.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mcpResult, result, err := tt.app.SemanticSearch(context.Background(), &mcp.CallToolRequest{}, SemanticSearchParams{Query: "roadmap"})
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+
+			if err.Error() != tt.expectedError {
+				t.Errorf("expected error %q, got %q", tt.expectedError, err.Error())
+			}
+
+			if mcpResult == nil || !mcpResult.IsError {
+				t.Error("expected MCP error result")
+			}
+
+			if result != nil {
+				t.Error("expected nil result on error")
+			}
+		})
+	}
+}
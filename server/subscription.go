@@ -0,0 +1,284 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// DefaultPollInterval is the interval to check the subscribed objects for changes.
+	DefaultPollInterval = 30 * time.Second
+	// maxPollBackoff is the maximum interval to poll while the Anytype API keeps failing.
+	maxPollBackoff = 5 * time.Minute
+	// pollPageSize is the number of objects fetched per search request when polling.
+	pollPageSize = 50
+)
+
+type subscription struct {
+	spaceId  string
+	objectId string
+	sessions map[*mcp.ServerSession]bool
+	// checked is true once the baseline is taken, objects without date keep a zero last modified date.
+	checked      bool
+	lastModified time.Time
+}
+
+// Poller tracks the last modified date of the subscribed objects and notifies when they change.
+type Poller struct {
+	client     *anytype.Anytype
+	interval   time.Duration
+	maxBackoff time.Duration
+
+	mu            sync.Mutex
+	subscriptions map[string]*subscription
+	sessions      map[*mcp.ServerSession]bool
+	// checkpoints is the newest last modified date seen per space, the next poll stops there.
+	checkpoints map[string]time.Time
+}
+
+// NewPoller creates a poller which checks the subscribed objects every interval.
+func NewPoller(client *anytype.Anytype, interval time.Duration) *Poller {
+	return &Poller{
+		client:        client,
+		interval:      interval,
+		maxBackoff:    max(interval, maxPollBackoff),
+		subscriptions: make(map[string]*subscription),
+		sessions:      make(map[*mcp.ServerSession]bool),
+		checkpoints:   make(map[string]time.Time),
+	}
+}
+
+// Subscribe starts tracking the object resource of the request, the subscriptions of the session
+// are released when the session is closed.
+func (p *Poller) Subscribe(ctx context.Context, req *mcp.SubscribeRequest) error {
+	uri := req.Params.URI
+
+	spaceId, objectId, ok := parseObjectURI(uri)
+	if !ok {
+		return mcp.ResourceNotFoundError(uri)
+	}
+
+	p.mu.Lock()
+	if sub, ok := p.subscriptions[uri]; ok {
+		p.addSession(sub, req.Session)
+		p.mu.Unlock()
+		return nil
+	}
+	p.mu.Unlock()
+
+	res, err := p.client.GetObject(anytype.WithoutCache(ctx), anytype.GetObjectInput{
+		Params: anytype.GetObjectParams{SpaceId: spaceId, ObjectId: objectId},
+	})
+	if errors.Is(err, anytype.ErrNotFound) {
+		return mcp.ResourceNotFoundError(uri)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sub, ok := p.subscriptions[uri]
+	if !ok {
+		// The baseline is taken by the next poll when Anytype is not reachable now.
+		sub = &subscription{spaceId: spaceId, objectId: objectId, sessions: make(map[*mcp.ServerSession]bool)}
+		if err == nil {
+			sub.checked = true
+			sub.lastModified = res.Object.LastModified()
+		}
+		p.subscriptions[uri] = sub
+	}
+
+	p.addSession(sub, req.Session)
+	return nil
+}
+
+// addSession adds the session to the subscription and releases its subscriptions once it is closed.
+func (p *Poller) addSession(sub *subscription, session *mcp.ServerSession) {
+	sub.sessions[session] = true
+	if session == nil || p.sessions[session] {
+		return
+	}
+
+	p.sessions[session] = true
+	go func() {
+		_ = session.Wait()
+		p.release(session)
+	}()
+}
+
+// release removes the session from all subscriptions.
+func (p *Poller) release(session *mcp.ServerSession) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.sessions, session)
+	for uri, sub := range p.subscriptions {
+		delete(sub.sessions, session)
+		if len(sub.sessions) == 0 {
+			delete(p.subscriptions, uri)
+		}
+	}
+}
+
+// Unsubscribe stops tracking the object resource when no session subscribes to it.
+func (p *Poller) Unsubscribe(ctx context.Context, req *mcp.UnsubscribeRequest) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sub, ok := p.subscriptions[req.Params.URI]
+	if !ok {
+		return nil
+	}
+
+	delete(sub.sessions, req.Session)
+	if len(sub.sessions) == 0 {
+		delete(p.subscriptions, req.Params.URI)
+	}
+
+	return nil
+}
+
+// Run polls until the context is done, the interval is doubled up to the maximum backoff while polling fails.
+func (p *Poller) Run(ctx context.Context, notify func(ctx context.Context, uri string) error) {
+	delay := p.interval
+
+	for {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		changed, err := p.poll(ctx)
+		for _, uri := range changed {
+			_ = notify(ctx, uri)
+		}
+
+		if err != nil {
+			delay = min(delay*2, p.maxBackoff)
+			continue
+		}
+		delay = p.interval
+	}
+}
+
+// watchedSpace is the subscribed objects of a space in a poll.
+type watchedSpace struct {
+	uris  map[string]string
+	since time.Time
+	// full is true when a subscription has no baseline and the space is searched until it is seen
+	full bool
+}
+
+// poll returns the uris of the subscribed objects modified since the last poll.
+func (p *Poller) poll(ctx context.Context) ([]string, error) {
+	p.mu.Lock()
+	spaces := make(map[string]*watchedSpace)
+	for uri, sub := range p.subscriptions {
+		space, ok := spaces[sub.spaceId]
+		if !ok {
+			space = &watchedSpace{uris: make(map[string]string), since: p.checkpoints[sub.spaceId]}
+			spaces[sub.spaceId] = space
+		}
+
+		space.uris[sub.objectId] = uri
+		switch {
+		case !sub.checked:
+			space.full = true
+		case sub.lastModified.IsZero() || !p.checkpoints[sub.spaceId].IsZero():
+		case space.since.IsZero() || sub.lastModified.Before(space.since):
+			// fall back to the oldest subscription before the first checkpoint is taken
+			space.since = sub.lastModified
+		}
+	}
+
+	for spaceId := range p.checkpoints {
+		if _, ok := spaces[spaceId]; !ok {
+			delete(p.checkpoints, spaceId)
+		}
+	}
+	p.mu.Unlock()
+
+	var changed []string
+	var errs []error
+	for spaceId, space := range spaces {
+		if space.full {
+			space.since = time.Time{}
+		}
+
+		modified, newest, err := p.pollSpace(ctx, spaceId, space)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		p.mu.Lock()
+		if newest.After(p.checkpoints[spaceId]) {
+			p.checkpoints[spaceId] = newest
+		}
+
+		for uri, at := range modified {
+			sub, ok := p.subscriptions[uri]
+			if !ok {
+				continue
+			}
+
+			checked := sub.checked
+			sub.checked = true
+			if !at.After(sub.lastModified) {
+				continue
+			}
+
+			if checked {
+				changed = append(changed, uri)
+			}
+			sub.lastModified = at
+		}
+		p.mu.Unlock()
+	}
+
+	return changed, errors.Join(errs...)
+}
+
+// pollSpace searches the objects sorted by last modified date until all subscribed objects are seen
+// or reaching the objects not modified since the checkpoint, objects without date are skipped when comparing.
+// The newest last modified date seen is returned as the next checkpoint.
+func (p *Poller) pollSpace(ctx context.Context, spaceId string, space *watchedSpace) (map[string]time.Time, time.Time, error) {
+	search := anytype.SearchInput{
+		Params: anytype.SearchParams{SpaceId: spaceId, Limit: pollPageSize},
+		Body: anytype.SearchBody{
+			Sort: &anytype.SearchSort{PropertyKey: "last_modified_date", Direction: "desc"},
+		},
+	}
+
+	var newest time.Time
+	modified := make(map[string]time.Time)
+	for object, err := range p.client.SearchAll(anytype.WithoutCache(ctx), search, 0) {
+		if err != nil {
+			return modified, newest, err
+		}
+
+		at := object.LastModified()
+		if !space.since.IsZero() && !at.IsZero() && !at.After(space.since) {
+			break
+		}
+
+		if at.After(newest) {
+			newest = at
+		}
+
+		if uri, ok := space.uris[object.ID]; ok {
+			modified[uri] = at
+			if len(modified) == len(space.uris) {
+				break
+			}
+		}
+	}
+
+	return modified, newest, nil
+}
@@ -0,0 +1,292 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// newWatchedServer serves obj1 and obj2 in space1, obj1 is modified at the returned modified time.
+func newWatchedServer(t *testing.T) (*anytype.Anytype, *atomic.Value) {
+	t.Helper()
+
+	modified := &atomic.Value{}
+	modified.Store("2025-05-20T10:00:00Z")
+
+	object := func(id, at string) string {
+		return fmt.Sprintf(`{"id":%q,"name":%q,"properties":[{"key":"last_modified_date","format":"date","date":%q}]}`, id, id, at)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/spaces/space1/objects/obj1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"object":%s}`, object("obj1", modified.Load().(string)))
+	})
+	mux.HandleFunc("POST /v1/spaces/space1/search", func(w http.ResponseWriter, r *http.Request) {
+		// sorted by last modified date in descending order, the undated obj1 is listed last
+		objects := []string{object("obj1", modified.Load().(string)), object("obj2", "2025-05-19T10:00:00Z")}
+		if modified.Load().(string) < "2025-05-19T10:00:00Z" {
+			objects[0], objects[1] = objects[1], objects[0]
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[%s,%s],"pagination":{"total":2}}`, objects[0], objects[1])
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"not_found","message":"object not found","object":"error","status":404}`))
+	})
+
+	api := httptest.NewServer(mux)
+	t.Cleanup(api.Close)
+
+	return anytype.New("test-api-key", anytype.WithApiServer(api.URL), anytype.WithRetry(anytype.RetryPolicy{})), modified
+}
+
+func subscribe(t *testing.T, poller *Poller, uri string) error {
+	t.Helper()
+
+	return poller.Subscribe(context.Background(), &mcp.SubscribeRequest{Params: &mcp.SubscribeParams{URI: uri}})
+}
+
+func TestPoller_Subscribe(t *testing.T) {
+	client, _ := newWatchedServer(t)
+
+	tests := []struct {
+		name    string
+		uri     string
+		wantErr bool
+	}{
+		{name: "existing object", uri: "anytype://space1/obj1"},
+		{name: "missing object", uri: "anytype://space1/missing", wantErr: true},
+		{name: "invalid uri", uri: "https://space1/obj1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			poller := NewPoller(client, time.Minute)
+			err := subscribe(t, poller, tt.uri)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+
+			if _, ok := poller.subscriptions[tt.uri]; ok == tt.wantErr {
+				t.Errorf("expected subscribed %v, got %v", !tt.wantErr, ok)
+			}
+		})
+	}
+}
+
+// connectPoller connects a client session to a server which subscribes with the poller.
+func connectPoller(t *testing.T, server *mcp.Server) *mcp.ClientSession {
+	t.Helper()
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(context.Background(), serverTransport, nil); err != nil {
+		t.Fatalf("failed to connect server: %v", err)
+	}
+
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "test"}, nil).Connect(context.Background(), clientTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect client: %v", err)
+	}
+	t.Cleanup(func() { _ = session.Close() })
+
+	return session
+}
+
+func newPollerServer(client *anytype.Anytype, poller *Poller) *mcp.Server {
+	server := mcp.NewServer(&mcp.Implementation{Name: "anytype", Version: "test"}, &mcp.ServerOptions{
+		SubscribeHandler:   poller.Subscribe,
+		UnsubscribeHandler: poller.Unsubscribe,
+	})
+	server.AddResourceTemplate(&mcp.ResourceTemplate{Name: "object", URITemplate: ObjectURITemplate}, New(client).ReadObject)
+
+	return server
+}
+
+func (p *Poller) subscribed(uri string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ok := p.subscriptions[uri]
+	return ok
+}
+
+func TestPoller_Unsubscribe(t *testing.T) {
+	client, _ := newWatchedServer(t)
+	poller := NewPoller(client, time.Minute)
+	server := newPollerServer(client, poller)
+
+	uri := "anytype://space1/obj1"
+	sessions := []*mcp.ClientSession{connectPoller(t, server), connectPoller(t, server)}
+	for _, session := range sessions {
+		if err := session.Subscribe(context.Background(), &mcp.SubscribeParams{URI: uri}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	if err := sessions[0].Unsubscribe(context.Background(), &mcp.UnsubscribeParams{URI: uri}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !poller.subscribed(uri) {
+		t.Error("expected subscription to be kept for the other session")
+	}
+
+	if err := sessions[1].Unsubscribe(context.Background(), &mcp.UnsubscribeParams{URI: uri}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if poller.subscribed(uri) {
+		t.Error("expected subscription to be removed")
+	}
+}
+
+func TestPoller_SessionClosed(t *testing.T) {
+	client, _ := newWatchedServer(t)
+	poller := NewPoller(client, time.Minute)
+	server := newPollerServer(client, poller)
+
+	uri := "anytype://space1/obj1"
+	sessions := []*mcp.ClientSession{connectPoller(t, server), connectPoller(t, server)}
+	for _, session := range sessions {
+		if err := session.Subscribe(context.Background(), &mcp.SubscribeParams{URI: uri}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	_ = sessions[0].Close()
+	time.Sleep(50 * time.Millisecond)
+	if !poller.subscribed(uri) {
+		t.Error("expected subscription to be kept for the other session")
+	}
+
+	_ = sessions[1].Close()
+	deadline := time.Now().Add(2 * time.Second)
+	for poller.subscribed(uri) {
+		if time.Now().After(deadline) {
+			t.Fatal("expected subscription to be released when all sessions are closed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestPoller_Poll(t *testing.T) {
+	client, modified := newWatchedServer(t)
+	poller := NewPoller(client, time.Minute)
+
+	if err := subscribe(t, poller, "anytype://space1/obj1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	changed, err := poller.poll(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(changed) != 0 {
+		t.Errorf("expected no changes, got %v", changed)
+	}
+
+	modified.Store("2025-05-21T10:00:00Z")
+	changed, err = poller.poll(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := []string{"anytype://space1/obj1"}
+	if !reflect.DeepEqual(changed, expected) {
+		t.Errorf("expected changes %v, got %v", expected, changed)
+	}
+
+	changed, _ = poller.poll(context.Background())
+	if len(changed) != 0 {
+		t.Errorf("expected no changes after notified, got %v", changed)
+	}
+}
+
+func TestPoller_PollUndated(t *testing.T) {
+	client, modified := newWatchedServer(t)
+	poller := NewPoller(client, time.Minute)
+
+	modified.Store("")
+	if err := subscribe(t, poller, "anytype://space1/obj1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	changed, err := poller.poll(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(changed) != 0 {
+		t.Errorf("expected no changes, got %v", changed)
+	}
+
+	expected := time.Date(2025, 5, 19, 10, 0, 0, 0, time.UTC)
+	if checkpoint := poller.checkpoints["space1"]; !checkpoint.Equal(expected) {
+		t.Errorf("expected checkpoint %v skipping the undated object, got %v", expected, checkpoint)
+	}
+
+	modified.Store("2025-05-21T10:00:00Z")
+	changed, err = poller.poll(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(changed, []string{"anytype://space1/obj1"}) {
+		t.Errorf("expected the undated object notified once dated, got %v", changed)
+	}
+}
+
+func TestPoller_Run(t *testing.T) {
+	client, modified := newWatchedServer(t)
+	poller := NewPoller(client, 10*time.Millisecond)
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "anytype", Version: "test"}, &mcp.ServerOptions{
+		SubscribeHandler:   poller.Subscribe,
+		UnsubscribeHandler: poller.Unsubscribe,
+	})
+	server.AddResourceTemplate(&mcp.ResourceTemplate{Name: "object", URITemplate: ObjectURITemplate}, New(client).ReadObject)
+
+	updated := make(chan string, 1)
+	mcpClient := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "test"}, &mcp.ClientOptions{
+		ResourceUpdatedHandler: func(ctx context.Context, req *mcp.ResourceUpdatedNotificationRequest) {
+			updated <- req.Params.URI
+		},
+	})
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(context.Background(), serverTransport, nil); err != nil {
+		t.Fatalf("failed to connect server: %v", err)
+	}
+	session, err := mcpClient.Connect(context.Background(), clientTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect client: %v", err)
+	}
+	defer func() { _ = session.Close() }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go poller.Run(ctx, func(ctx context.Context, uri string) error {
+		return server.ResourceUpdated(ctx, &mcp.ResourceUpdatedNotificationParams{URI: uri})
+	})
+
+	if err := session.Subscribe(context.Background(), &mcp.SubscribeParams{URI: "anytype://space1/obj1"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	modified.Store("2025-05-21T10:00:00Z")
+
+	select {
+	case uri := <-updated:
+		if uri != "anytype://space1/obj1" {
+			t.Errorf("expected update of anytype://space1/obj1, got %s", uri)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected resource updated notification")
+	}
+}
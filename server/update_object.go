@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type UpdateObjectParams struct {
+	ObjectId   string         `json:"objectId" jsonschema:"the id of the object to update"`
+	SpaceId    string         `json:"spaceId" jsonschema:"the space id of the object to update"`
+	Name       string         `json:"name,omitempty" jsonschema:"the new name of the object"`
+	Markdown   string         `json:"markdown,omitempty" jsonschema:"the new markdown body of the object"`
+	Properties map[string]any `json:"properties,omitempty" jsonschema:"the property name to value map, tags are set by name"`
+}
+
+func (a *App) UpdateObject(ctx context.Context, req *mcp.CallToolRequest, params UpdateObjectParams) (*mcp.CallToolResult, *WriteObjectResult, error) {
+	props, err := a.propertyInputs(ctx, params.SpaceId, params.Properties)
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("object %s not found in space %s; try search to find the object id", params.ObjectId, params.SpaceId))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	res, err := a.anytype.UpdateObject(ctx, anytype.UpdateObjectInput{
+		Params: anytype.UpdateObjectParams{
+			ObjectId: params.ObjectId,
+			SpaceId:  params.SpaceId,
+		},
+		Body: anytype.UpdateObjectBody{
+			Name:       params.Name,
+			Body:       params.Markdown,
+			Properties: props,
+		},
+	})
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("object %s not found in space %s; try search to find the object id", params.ObjectId, params.SpaceId))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	return nil, &WriteObjectResult{
+		ObjectId: res.Object.ID,
+		SpaceId:  res.Object.SpaceId,
+		Name:     res.Object.Name,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestUpdateObject_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/v1/spaces/space1/objects/obj1" {
+			t.Errorf("expected path /v1/spaces/space1/objects/obj1, got %s", r.URL.Path)
+		}
+
+		var body anytype.UpdateObjectBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+
+		expected := anytype.UpdateObjectBody{Name: "Renamed", Body: "Updated"}
+		if !reflect.DeepEqual(body, expected) {
+			t.Errorf("expected body %+v, got %+v", expected, body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&anytype.UpdateObjectOutput{
+			Object: anytype.Object{ID: "obj1", SpaceId: "space1", Name: body.Name},
+		})
+	}))
+	defer server.Close()
+
+	client := anytype.New("test-api-key", anytype.WithApiServer(server.URL))
+	app := New(client)
+	req := &mcp.CallToolRequest{}
+
+	_, result, err := app.UpdateObject(context.Background(), req, UpdateObjectParams{
+		ObjectId: "obj1",
+		SpaceId:  "space1",
+		Name:     "Renamed",
+		Markdown: "Updated",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &WriteObjectResult{ObjectId: "obj1", SpaceId: "space1", Name: "Renamed"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected result %+v, got %+v", expected, result)
+	}
+}
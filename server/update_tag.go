@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elct9620/anytype-mcp-lite/pkg/anytype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type UpdateTagParams struct {
+	SpaceId  string `json:"spaceId" jsonschema:"the space id of the property"`
+	Property string `json:"property" jsonschema:"the key or name of the select or multi_select property"`
+	Tag      string `json:"tag" jsonschema:"the name or id of the tag to update"`
+	Name     string `json:"name,omitempty" jsonschema:"the new name of the tag"`
+	Color    string `json:"color,omitempty" jsonschema:"the new color of the tag: grey, yellow, orange, red, pink, purple, blue, ice, teal or lime"`
+}
+
+func (a *App) UpdateTag(ctx context.Context, req *mcp.CallToolRequest, params UpdateTagParams) (*mcp.CallToolResult, *TagItem, error) {
+	result, err := a.updateTag(ctx, params)
+	if err != nil {
+		err = explainError(err, fmt.Sprintf("tag %q not found; use list-tags to find the tags", params.Tag))
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: err.Error()},
+			},
+		}, nil, err
+	}
+
+	return nil, result, nil
+}
+
+func (a *App) updateTag(ctx context.Context, params UpdateTagParams) (*TagItem, error) {
+	if err := validateColor(params.Color); err != nil {
+		return nil, err
+	}
+
+	def, err := a.selectProperty(ctx, params.SpaceId, params.Property)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := a.tagIds(ctx, params.SpaceId, def, []string{params.Tag})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := a.anytype.UpdateTag(ctx, anytype.UpdateTagInput{
+		Params: anytype.TagParams{SpaceId: params.SpaceId, PropertyId: def.ID, TagId: ids[0]},
+		Body:   anytype.UpdateTagBody{Name: params.Name, Color: params.Color},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TagItem{ID: res.Tag.ID, Name: res.Tag.Name, Color: res.Tag.Color}, nil
+}
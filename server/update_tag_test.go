@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestUpdateTag(t *testing.T) {
+	app := newTagServer(t)
+
+	tests := []struct {
+		name     string
+		params   UpdateTagParams
+		expected *TagItem
+		wantErr  bool
+	}{
+		{
+			name:     "rename tag by name",
+			params:   UpdateTagParams{SpaceId: "space1", Property: "status", Tag: "to do", Name: "Backlog"},
+			expected: &TagItem{ID: "tag1", Name: "Backlog", Color: "grey"},
+		},
+		{
+			name:     "recolor tag by id",
+			params:   UpdateTagParams{SpaceId: "space1", Property: "status", Tag: "tag2", Color: "teal"},
+			expected: &TagItem{ID: "tag2", Name: "Done", Color: "teal"},
+		},
+		{
+			name:    "unknown tag",
+			params:  UpdateTagParams{SpaceId: "space1", Property: "status", Tag: "Blocked", Color: "red"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown color",
+			params:  UpdateTagParams{SpaceId: "space1", Property: "status", Tag: "Done", Color: "green"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, result, err := app.UpdateTag(context.Background(), &mcp.CallToolRequest{}, tt.params)
+			if tt.wantErr {
+				if err == nil || res == nil || !res.IsError {
+					t.Fatalf("expected error result, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected result %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}